@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+type (
+	// benchmarkResult is a single result line of a benchmark, e.g.
+	// "BenchmarkSprintf-8   1000000   156.9 ns/op   12 B/op   1 allocs/op"
+	benchmarkResult struct {
+		Name    string
+		Package string
+		// the GOMAXPROCS value appended to the name of the benchmark, 0 if it was not appended
+		Procs      int
+		Iterations int64
+		// every value and unit pair of the result in the reported order, including the custom metrics reported
+		// with b.ReportMetric
+		Metrics            []*benchmarkMetric
+		TestFileName       string
+		TestFunctionDetail testFunctionFilePos
+	}
+
+	benchmarkMetric struct {
+		Value float64
+		Unit  string
+	}
+)
+
+// the units reported by every benchmark run with -benchmem (or b.ReportAllocs), listed first in the report
+var standardBenchmarkUnits = []string{"ns/op", "MB/s", "B/op", "allocs/op"}
+
+// parseBenchmarkLine parses a line of output as a benchmark result, returning nil if the line is not a benchmark
+// result. The test name is the name of the benchmark as reported by the event containing the line, it is empty
+// for versions of go that do not associate the output of benchmarks with the benchmark.
+func parseBenchmarkLine(line string, testName string) *benchmarkResult {
+	fields := strings.Fields(line)
+	// the name, the number of iterations and at least one value and unit pair
+	if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return nil
+	}
+	iterations, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+	result := &benchmarkResult{Name: fields[0], Iterations: iterations}
+	for i := 2; i < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil
+		}
+		result.Metrics = append(result.Metrics, &benchmarkMetric{Value: value, Unit: fields[i+1]})
+	}
+	// the "-N" suffix is only appended when GOMAXPROCS is not 1, a benchmark named like "BenchmarkSize-3" without a
+	// test name can therefore not be told apart from a suffix
+	if result.Name != testName {
+		if i := strings.LastIndex(result.Name, "-"); i > 0 {
+			if procs, err := strconv.Atoi(result.Name[i+1:]); err == nil && (testName == "" || testName == result.Name[:i]) {
+				result.Name = result.Name[:i]
+				result.Procs = procs
+			}
+		}
+	}
+	return result
+}
+
+// Metric returns the formatted value of the metric with the given unit, or an empty string if the benchmark did
+// not report the unit.
+func (b *benchmarkResult) Metric(unit string) string {
+	for _, metric := range b.Metrics {
+		if metric.Unit == unit {
+			return strconv.FormatFloat(metric.Value, 'f', -1, 64)
+		}
+	}
+	return ""
+}
+
+// benchmarkUnits returns the units reported by the benchmarks, the standard units first followed by the custom
+// units in the order they were first reported.
+func benchmarkUnits(benchmarks []*benchmarkResult) []string {
+	reported := map[string]bool{}
+	var customUnits []string
+	for _, benchmark := range benchmarks {
+		for _, metric := range benchmark.Metrics {
+			if !reported[metric.Unit] {
+				reported[metric.Unit] = true
+				customUnits = append(customUnits, metric.Unit)
+			}
+		}
+	}
+	var units []string
+	for _, unit := range standardBenchmarkUnits {
+		if reported[unit] {
+			units = append(units, unit)
+		}
+	}
+	for _, unit := range customUnits {
+		if !isStandardBenchmarkUnit(unit) {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
+
+func isStandardBenchmarkUnit(unit string) bool {
+	for _, standardUnit := range standardBenchmarkUnits {
+		if unit == standardUnit {
+			return true
+		}
+	}
+	return false
+}
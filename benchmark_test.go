@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBenchmarkLine(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Equal(&benchmarkResult{
+		Name:       "BenchmarkSprintf",
+		Procs:      8,
+		Iterations: 1000000,
+		Metrics: []*benchmarkMetric{
+			{Value: 156.9, Unit: "ns/op"},
+			{Value: 12, Unit: "B/op"},
+			{Value: 1, Unit: "allocs/op"},
+		},
+	}, parseBenchmarkLine("BenchmarkSprintf-8 \t 1000000\t       156.9 ns/op\t      12 B/op\t       1 allocs/op\n", "BenchmarkSprintf"))
+	assertions.Equal(&benchmarkResult{
+		Name:       "BenchmarkSub/size-3",
+		Iterations: 1000,
+		Metrics: []*benchmarkMetric{
+			{Value: 2.341, Unit: "ns/op"},
+			{Value: 42, Unit: "widgets/op"},
+		},
+	}, parseBenchmarkLine("BenchmarkSub/size-3         \t    1000\t         2.341 ns/op\t        42.00 widgets/op\n", "BenchmarkSub/size-3"))
+	// older versions of go do not associate the output with the benchmark
+	benchmark := parseBenchmarkLine("BenchmarkSprintf-4 \t 1000\t 100 ns/op\n", "")
+	assertions.Equal("BenchmarkSprintf", benchmark.Name)
+	assertions.Equal(4, benchmark.Procs)
+
+	assertions.Nil(parseBenchmarkLine("BenchmarkSprintf\n", "BenchmarkSprintf"))
+	assertions.Nil(parseBenchmarkLine("=== RUN   BenchmarkSprintf\n", "BenchmarkSprintf"))
+	assertions.Nil(parseBenchmarkLine("BenchmarkSprintf \t 1000\t slow ns/op\n", "BenchmarkSprintf"))
+	assertions.Nil(parseBenchmarkLine("    bench_test.go:12: BenchmarkSprintf 1000 100 ns/op\n", "BenchmarkSprintf"))
+}
+
+func TestBenchmarkUnits(t *testing.T) {
+	assertions := assert.New(t)
+	benchmarks := []*benchmarkResult{
+		{Metrics: []*benchmarkMetric{{Unit: "ns/op"}, {Unit: "widgets/op"}}},
+		{Metrics: []*benchmarkMetric{{Unit: "ns/op"}, {Unit: "B/op"}, {Unit: "allocs/op"}, {Unit: "gadgets/op"}}},
+	}
+	assertions.Equal([]string{"ns/op", "B/op", "allocs/op", "widgets/op", "gadgets/op"}, benchmarkUnits(benchmarks))
+	assertions.Equal("", benchmarks[0].Metric("B/op"))
+	assertions.Nil(benchmarkUnits(nil))
+}
+
+func TestReadTestDataFromStdInWithBenchmarks(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Action":"run","Package":"foo","Test":"BenchmarkSprintf"}
+{"Action":"output","Package":"foo","Test":"BenchmarkSprintf","Output":"BenchmarkSprintf\n"}
+{"Action":"output","Package":"foo","Test":"BenchmarkSprintf","Output":"BenchmarkSprintf-8 \t    1000\t       156.9 ns/op\n"}
+{"Action":"run","Package":"foo","Test":"BenchmarkSub"}
+{"Action":"run","Package":"foo","Test":"BenchmarkSub/small"}
+{"Action":"output","Package":"foo","Test":"BenchmarkSub/small","Output":"BenchmarkSub/small-8 \t    1000\t         2.341 ns/op\t        42.00 widgets/op\n"}
+{"Action":"run","Package":"foo","Test":"BenchmarkBroken"}
+{"Action":"output","Package":"foo","Test":"BenchmarkBroken","Output":"--- FAIL: BenchmarkBroken\n"}
+{"Action":"fail","Package":"foo","Test":"BenchmarkBroken"}
+{"Action":"output","Package":"foo","Output":"BenchmarkLegacy-8 \t    50\t  20000 ns/op\n"}
+{"Action":"fail","Package":"foo","Elapsed":0.5}
+`
+	cmd := &cobra.Command{}
+	_, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.True(allTests["foo.BenchmarkSprintf"].Passed)
+	assertions.True(allTests["foo.BenchmarkSub"].Passed)
+	assertions.True(allTests["foo.BenchmarkSub/small"].Passed)
+	assertions.False(allTests["foo.BenchmarkBroken"].Passed)
+
+	benchmarks := allPackages["foo"].Benchmarks
+	assertions.Len(benchmarks, 3)
+	assertions.Equal("BenchmarkSprintf", benchmarks[0].Name)
+	assertions.Equal("foo", benchmarks[0].Package)
+	assertions.Equal("BenchmarkSub/small", benchmarks[1].Name)
+	assertions.Equal("42", benchmarks[1].Metric("widgets/op"))
+	assertions.Equal("BenchmarkLegacy", benchmarks[2].Name)
+
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	testFileDetailsByPackage := testFileDetailsByPackage{
+		"foo": {
+			"BenchmarkSub": &testFileDetail{
+				FileName:            "foo_test.go",
+				TestFunctionFilePos: testFunctionFilePos{Line: 17, Col: 1},
+			},
+		},
+	}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err = generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Len(tmplData.Benchmarks, 3)
+	assertions.Equal([]string{"ns/op", "widgets/op"}, tmplData.BenchmarkUnits)
+	assertions.Equal("foo_test.go", benchmarks[1].TestFileName)
+	assertions.Equal(17, benchmarks[1].TestFunctionDetail.Line)
+	assertions.Contains(buffer.String(), `id="benchmarkTable"`)
+	assertions.Contains(buffer.String(), "<th>widgets/op</th>")
+	assertions.Contains(buffer.String(), "foo_test.go:17")
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// packageCoverage is the percentage of statements covered by the tests of a package, as printed by go test -cover.
+type packageCoverage struct {
+	Percent float64
+	// the number of statements of the package, used to weight the total coverage, 0 if unknown
+	Statements int
+	// true if the coverage is below the --min-coverage threshold
+	BelowMinimum bool
+}
+
+// the coverage printed by go test -cover for every package, e.g. "coverage: 73.4% of statements" or
+// "coverage: 45.0% of statements in ./..." when using -coverpkg. The same text is appended to the "ok" line of the
+// package.
+var coverageRegexp = regexp.MustCompile(`coverage: (\d+(?:\.\d+)?)% of statements`)
+
+// readCoverageOutput reads the coverage of a package from a line of its output. A package without statements
+// ("coverage: [no statements]") has no coverage.
+func readCoverageOutput(pkg *packageStatus, output string) {
+	match := coverageRegexp.FindStringSubmatch(output)
+	if match == nil {
+		return
+	}
+	percent, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return
+	}
+	pkg.Coverage = &packageCoverage{Percent: percent}
+}
+
+// readCoverageStatements counts the statements of the packages with a coverage, reading their go files from the
+// package directories reported by go list. Statements are counted the way go test -cover counts them: every
+// statement of a block, case or select clause, without the statements of the blocks nested inside of it, and
+// every "else if".
+func readCoverageStatements(allPackages map[string]*packageStatus, goListByPackage goListJSONByPackage) {
+	for _, pkg := range allPackages {
+		goList := goListByPackage[pkg.Name]
+		if pkg.Coverage == nil || goList == nil {
+			continue
+		}
+		statements := 0
+		for _, file := range goList.GoFiles {
+			f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(goList.Dir, file), nil, 0)
+			if err != nil {
+				// the weight of the package is unknown if any of its files can not be parsed
+				statements = 0
+				break
+			}
+			ast.Inspect(f, func(n ast.Node) bool {
+				switch x := n.(type) {
+				case *ast.BlockStmt:
+					statements += countStatements(x.List)
+				case *ast.CaseClause:
+					statements += countStatements(x.Body)
+				case *ast.CommClause:
+					statements += countStatements(x.Body)
+				case *ast.IfStmt:
+					// the condition of an "else if" is counted as a statement of its own
+					if _, elseIf := x.Else.(*ast.IfStmt); elseIf {
+						statements++
+					}
+				}
+				return true
+			})
+		}
+		pkg.Coverage.Statements = statements
+	}
+}
+
+// readProfileStatements replaces the statements counted from the go files with the statements of the coverage
+// profile, which are the statements go test -cover counts, for every package with a coverage found in the profile.
+func readProfileStatements(allPackages map[string]*packageStatus, files []*coverageFile) {
+	statementsByPackage := map[string]int{}
+	for _, file := range files {
+		statementsByPackage[file.Package] += file.Statements
+	}
+	for packageName, statements := range statementsByPackage {
+		if pkg := allPackages[packageName]; pkg != nil && pkg.Coverage != nil && statements > 0 {
+			pkg.Coverage.Statements = statements
+		}
+	}
+}
+
+func countStatements(list []ast.Stmt) int {
+	count := 0
+	for _, stmt := range list {
+		switch stmt.(type) {
+		case *ast.EmptyStmt, *ast.CaseClause, *ast.CommClause:
+			// the clauses of a switch or select statement are blocks of their own
+		default:
+			count++
+		}
+	}
+	return count
+}
+
+// totalCoverage returns the coverage of all packages with a coverage, weighted by their number of statements. If
+// the number of statements of any package is unknown, the packages are weighted equally. The minimum coverage is
+// ignored if it is 0.
+func totalCoverage(packages []*packageStatus, minCoverage float64) *packageCoverage {
+	var covered []*packageCoverage
+	weighted := true
+	for _, pkg := range packages {
+		if pkg.Coverage != nil {
+			pkg.Coverage.BelowMinimum = pkg.Coverage.Percent < minCoverage
+			covered = append(covered, pkg.Coverage)
+			weighted = weighted && pkg.Coverage.Statements > 0
+		}
+	}
+	if len(covered) == 0 {
+		return nil
+	}
+	total := &packageCoverage{}
+	sum := 0.0
+	for _, coverage := range covered {
+		if weighted {
+			total.Statements += coverage.Statements
+			sum += coverage.Percent * float64(coverage.Statements)
+		} else {
+			sum += coverage.Percent
+		}
+	}
+	if weighted {
+		total.Percent = sum / float64(total.Statements)
+	} else {
+		total.Percent = sum / float64(len(covered))
+	}
+	total.BelowMinimum = total.Percent < minCoverage
+	return total
+}
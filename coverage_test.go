@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCoverage(t *testing.T) {
+	assertions := assert.New(t)
+	packageDir, err := ioutil.TempDir("", "go-test-report-coverage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(packageDir)
+	source := `package foo
+
+func Classify(n int) string {
+	if n < 0 {
+		return "negative"
+	} else if n == 0 {
+		return "zero"
+	}
+	switch {
+	case n < 10:
+		return "small"
+	default:
+	}
+	return "large"
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(packageDir, "foo.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	data := `{"Action":"run","Package":"foo","Test":"TestClassify"}
+{"Action":"output","Package":"foo","Test":"TestClassify","Output":"    foo_test.go:10: coverage: 1.0% of statements\n"}
+{"Action":"pass","Package":"foo","Test":"TestClassify","Elapsed":0.1}
+{"Action":"output","Package":"foo","Output":"coverage: 62.5% of statements\n"}
+{"Action":"output","Package":"foo","Output":"ok  \tfoo\t0.1s\tcoverage: 62.5% of statements\n"}
+{"Action":"pass","Package":"foo","Elapsed":0.1}
+{"Action":"output","Package":"bar","Output":"coverage: 90.0% of statements in ./...\n"}
+{"Action":"pass","Package":"bar","Elapsed":0.1}
+{"Action":"output","Package":"empty","Output":"coverage: [no statements]\n"}
+{"Action":"pass","Package":"empty","Elapsed":0.1}
+`
+	cmd := &cobra.Command{}
+	_, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.Equal(62.5, allPackages["foo"].Coverage.Percent)
+	assertions.Equal(90.0, allPackages["bar"].Coverage.Percent)
+	assertions.Nil(allPackages["empty"].Coverage)
+
+	readCoverageStatements(allPackages, goListJSONByPackage{
+		"foo":   {ImportPath: "foo", Dir: packageDir, GoFiles: []string{"foo.go"}},
+		"empty": {ImportPath: "empty", Dir: packageDir},
+	})
+	// the if, the else if, the switch and the return of the function, as well as the returns of the blocks
+	assertions.Equal(7, allPackages["foo"].Coverage.Statements)
+	assertions.Equal(0, allPackages["bar"].Coverage.Statements)
+
+	// the packages are weighted equally, since the number of statements of bar is unknown
+	tmplData := &templateData{numOfTestsPerGroup: 20, MinCoverage: 80}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err = generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage{}, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Equal(&packageCoverage{Percent: 76.25, BelowMinimum: true}, tmplData.Coverage)
+	assertions.True(allPackages["foo"].Coverage.BelowMinimum)
+	assertions.False(allPackages["bar"].Coverage.BelowMinimum)
+	assertions.Equal(1, tmplData.NumOfPackagesBelowMinCoverage)
+	assertions.Contains(buffer.String(), `<span class="packageCoverage belowMinimum" title="below the minimum coverage of 80%">62.5%</span>`)
+	assertions.Contains(buffer.String(), "Coverage: <strong>76.2%</strong>")
+	assertions.Contains(buffer.String(), `<table id="packageSummaryTable">`)
+	assertions.Contains(buffer.String(), `<td><span class="packageCoverage">90.0%</span></td>`)
+	assertions.Contains(buffer.String(), `<td><span class="packageCoverage belowMinimum">76.2%</span></td>`)
+}
+
+func TestReadCoverageOfUntestedPackage(t *testing.T) {
+	assertions := assert.New(t)
+	packageDir, err := ioutil.TempDir("", "go-test-report-coverage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(packageDir)
+	if err := ioutil.WriteFile(filepath.Join(packageDir, "untested.go"), []byte("package untested\n\nfunc Do() {\n\tprintln()\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// go test -cover ./... prints the coverage of the packages without tests
+	data := `{"Action":"run","Package":"foo","Test":"TestFunc"}
+{"Action":"pass","Package":"foo","Test":"TestFunc","Elapsed":0.1}
+{"Action":"output","Package":"foo","Output":"coverage: 10.0% of statements\n"}
+{"Action":"pass","Package":"foo","Elapsed":0.1}
+{"Action":"output","Package":"untested","Output":"\tuntested\t\tcoverage: 0.0% of statements\n"}
+{"Action":"skip","Package":"untested","Elapsed":0}
+`
+	cmd := &cobra.Command{}
+	allPackageNames, _, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.NotContains(allPackageNames, "untested")
+	packageNames := goListPackageNames(allPackageNames, allPackages)
+	assertions.Len(packageNames, 2)
+	assertions.Contains(packageNames, "foo")
+	assertions.Contains(packageNames, "untested")
+
+	allPackages["foo"].Coverage.Statements = 10
+	readCoverageStatements(allPackages, goListJSONByPackage{
+		"untested": {ImportPath: "untested", Dir: packageDir, GoFiles: []string{"untested.go"}},
+	})
+	assertions.Equal(1, allPackages["untested"].Coverage.Statements)
+	total := totalCoverage([]*packageStatus{allPackages["foo"], allPackages["untested"]}, 0)
+	assertions.Equal(11, total.Statements)
+	assertions.InDelta(100.0/11, total.Percent, 0.001)
+}
+
+func TestTotalCoverage(t *testing.T) {
+	assertions := assert.New(t)
+	packages := []*packageStatus{
+		{Name: "foo", Coverage: &packageCoverage{Percent: 50, Statements: 300}},
+		{Name: "bar", Coverage: &packageCoverage{Percent: 100, Statements: 100}},
+		{Name: "empty"},
+	}
+	assertions.Equal(&packageCoverage{Percent: 62.5, Statements: 400}, totalCoverage(packages, 0))
+	assertions.False(packages[0].Coverage.BelowMinimum)
+
+	total := totalCoverage(packages, 75)
+	assertions.True(total.BelowMinimum)
+	assertions.True(packages[0].Coverage.BelowMinimum)
+	assertions.False(packages[1].Coverage.BelowMinimum)
+
+	assertions.Nil(totalCoverage(packages[2:], 75))
+}
+
+func TestReadProfileStatements(t *testing.T) {
+	assertions := assert.New(t)
+	allPackages := map[string]*packageStatus{
+		"foo":       {Name: "foo", Coverage: &packageCoverage{Percent: 50, Statements: 12}},
+		"bar":       {Name: "bar", Coverage: &packageCoverage{Percent: 100, Statements: 4}},
+		"uncovered": {Name: "uncovered"},
+	}
+	readProfileStatements(allPackages, []*coverageFile{
+		{Package: "foo", Statements: 6},
+		{Package: "foo", Statements: 4},
+		{Package: "uncovered", Statements: 3},
+		{Package: "other", Statements: 5},
+	})
+	assertions.Equal(10, allPackages["foo"].Coverage.Statements)
+	assertions.Equal(4, allPackages["bar"].Coverage.Statements)
+	assertions.Nil(allPackages["uncovered"].Coverage)
+}
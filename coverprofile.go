@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"html"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type (
+	// coverageFile is a source file listed in the coverage profile of go test -coverprofile, annotated with the
+	// covered and uncovered blocks of the profile.
+	coverageFile struct {
+		// the file as named in the profile, e.g. "example.com/module/pkg/parser.go"
+		Name string
+		// the import path of the package of the file, e.g. "example.com/module/pkg"
+		Package           string
+		ID                string
+		Statements        int
+		CoveredStatements int
+		Percent           float64
+		// the annotated lines of the source file, empty if the source file was not found
+		Lines  []*coverageLine
+		blocks []*coverageBlock
+	}
+
+	// coverageLine is a line of a source file, highlighted and annotated as HTML.
+	coverageLine struct {
+		Number int
+		HTML   template.HTML
+	}
+
+	// coverageBlock is a line of the coverage profile, e.g. "example.com/module/pkg/parser.go:12.34,15.2 3 1"
+	coverageBlock struct {
+		StartLine int
+		StartCol  int
+		EndLine   int
+		EndCol    int
+		NumStmt   int
+		Count     int
+	}
+)
+
+// FileName returns the name of the file without its package path, e.g. "parser.go".
+func (f *coverageFile) FileName() string {
+	return path.Base(f.Name)
+}
+
+// readCoverageProfile reads the coverage profile written by go test -coverprofile and annotates the source files
+// it lists. The files are named by the import path of their package, which is resolved to a local directory using
+// "go list", just like the packages of the tests. The go list output of the packages of the tests is reused, go
+// list is only run for the other packages of the profile (e.g. when using -coverpkg).
+func readCoverageProfile(profileFile string, goListByPackage goListJSONByPackage) ([]*coverageFile, error) {
+	f, err := os.Open(profileFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	filesByName := map[string]*coverageFile{}
+	blocksByPos := map[string]*coverageBlock{}
+	lineScanner := bufio.NewScanner(f)
+	lineScanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNumber := 0
+	for lineScanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(lineScanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		name, block, err := parseCoverageBlock(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %s", profileFile, lineNumber, err)
+		}
+		// the blocks of a file are listed more than once if the profiles of several packages were merged (e.g. when
+		// using -coverpkg), a block is covered if any of the packages covered it
+		pos := fmt.Sprintf("%s:%d.%d,%d.%d", name, block.StartLine, block.StartCol, block.EndLine, block.EndCol)
+		if existing, exists := blocksByPos[pos]; exists {
+			existing.Count += block.Count
+			continue
+		}
+		blocksByPos[pos] = block
+		file, exists := filesByName[name]
+		if !exists {
+			file = &coverageFile{Name: name, Package: path.Dir(name)}
+			filesByName[name] = file
+		}
+		file.blocks = append(file.blocks, block)
+	}
+	if err := lineScanner.Err(); err != nil {
+		return nil, err
+	}
+
+	files := make([]*coverageFile, 0, len(filesByName))
+	for _, file := range filesByName {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	packageDirs := map[string]string{}
+	for i, file := range files {
+		file.ID = fmt.Sprintf("coverage-%d", i)
+		for _, block := range file.blocks {
+			file.Statements += block.NumStmt
+			if block.Count > 0 {
+				file.CoveredStatements += block.NumStmt
+			}
+		}
+		if file.Statements > 0 {
+			file.Percent = float64(file.CoveredStatements) * 100 / float64(file.Statements)
+		}
+		source, err := ioutil.ReadFile(resolveCoverageFile(file, goListByPackage, packageDirs))
+		if err != nil {
+			// the profile was probably written on another machine, the file is listed without its source
+			continue
+		}
+		file.Lines = annotateSource(source, file.blocks)
+	}
+	return files, nil
+}
+
+// parseCoverageBlock parses a line of a coverage profile, returning the name of the file and the block.
+func parseCoverageBlock(line string) (string, *coverageBlock, error) {
+	// the name of the file may contain colons, the block follows the last one
+	i := strings.LastIndex(line, ":")
+	if i < 0 {
+		return "", nil, fmt.Errorf("invalid coverage profile line %q", line)
+	}
+	block := &coverageBlock{}
+	_, err := fmt.Sscanf(line[i+1:], "%d.%d,%d.%d %d %d",
+		&block.StartLine, &block.StartCol, &block.EndLine, &block.EndCol, &block.NumStmt, &block.Count)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid coverage profile line %q", line)
+	}
+	return line[:i], block, nil
+}
+
+// resolveCoverageFile returns the local path of a file of the coverage profile. Files of packages outside of a
+// module may be named by their path, the other files are named by the import path of their package, which is
+// resolved using "go list". The directories of the packages resolved by go list are added to packageDirs, empty if
+// go list failed.
+func resolveCoverageFile(file *coverageFile, goListByPackage goListJSONByPackage, packageDirs map[string]string) string {
+	if _, err := os.Stat(file.Name); err == nil {
+		return file.Name
+	}
+	if goList := goListByPackage[file.Package]; goList != nil {
+		return filepath.Join(goList.Dir, path.Base(file.Name))
+	}
+	dir, exists := packageDirs[file.Package]
+	if !exists {
+		if goList, err := goListPackage(file.Package); err == nil {
+			dir = goList.Dir
+		}
+		packageDirs[file.Package] = dir
+	}
+	if dir == "" {
+		return file.Name
+	}
+	return filepath.Join(dir, path.Base(file.Name))
+}
+
+// blockOffsets returns the offsets of the start and the end of a block in the source split into lines. Blocks that
+// are not within the source, e.g. blocks of an outdated or truncated profile, are not ok.
+func blockOffsets(block *coverageBlock, lines []string, lineOffsets []int) (start int, end int, ok bool) {
+	if block.StartLine < 1 || block.EndLine > len(lines) || block.StartLine > block.EndLine {
+		return 0, 0, false
+	}
+	// the columns are 1-based, the end column is the column following the block
+	if block.StartCol < 1 || block.StartCol > len(lines[block.StartLine-1])+1 ||
+		block.EndCol < 1 || block.EndCol > len(lines[block.EndLine-1])+1 {
+		return 0, 0, false
+	}
+	start = lineOffsets[block.StartLine-1] + block.StartCol - 1
+	end = lineOffsets[block.EndLine-1] + block.EndCol - 1
+	return start, end, start <= end
+}
+
+// the classes of the tokens highlighted in the source view
+var coverageTokenClasses = map[token.Token]string{
+	token.COMMENT: "comment",
+	token.STRING:  "string",
+	token.CHAR:    "string",
+	token.INT:     "number",
+	token.FLOAT:   "number",
+	token.IMAG:    "number",
+}
+
+// annotateSource splits the source into lines of HTML, highlighting the keywords, literals and comments of the go
+// source and marking the covered and uncovered blocks.
+func annotateSource(source []byte, blocks []*coverageBlock) []*coverageLine {
+	tokenClasses := make([]string, len(source))
+	fileSet := token.NewFileSet()
+	tokenFile := fileSet.AddFile("", fileSet.Base(), len(source))
+	var s scanner.Scanner
+	// errors are ignored, the source is highlighted as far as possible
+	s.Init(tokenFile, source, nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		class := coverageTokenClasses[tok]
+		if tok.IsKeyword() {
+			class = "keyword"
+		}
+		if class == "" {
+			continue
+		}
+		start := tokenFile.Offset(pos)
+		for i := start; i < start+len(lit) && i < len(source); i++ {
+			tokenClasses[i] = class
+		}
+	}
+
+	lines := strings.Split(string(source), "\n")
+	lineOffsets := make([]int, len(lines))
+	offset := 0
+	for i, line := range lines {
+		lineOffsets[i] = offset
+		offset += len(line) + 1
+	}
+	// the blocks are applied in the order of their start, nested blocks start after the block containing them
+	sortedBlocks := append([]*coverageBlock{}, blocks...)
+	sort.SliceStable(sortedBlocks, func(i, j int) bool {
+		if sortedBlocks[i].StartLine != sortedBlocks[j].StartLine {
+			return sortedBlocks[i].StartLine < sortedBlocks[j].StartLine
+		}
+		return sortedBlocks[i].StartCol < sortedBlocks[j].StartCol
+	})
+	coverageClasses := make([]string, len(source))
+	for _, block := range sortedBlocks {
+		start, end, ok := blockOffsets(block, lines, lineOffsets)
+		if !ok {
+			continue
+		}
+		class := "uncovered"
+		if block.Count > 0 {
+			class = "covered"
+		}
+		for i := start; i < end && i < len(source); i++ {
+			coverageClasses[i] = class
+		}
+	}
+
+	coverageLines := make([]*coverageLine, 0, len(lines))
+	for i, line := range lines {
+		if i == len(lines)-1 && line == "" {
+			break
+		}
+		line = strings.TrimSuffix(line, "\r")
+		var lineHTML strings.Builder
+		start := lineOffsets[i]
+		runStart := 0
+		for j := 1; j <= len(line); j++ {
+			if j < len(line) && tokenClasses[start+j] == tokenClasses[start+runStart] &&
+				coverageClasses[start+j] == coverageClasses[start+runStart] {
+				continue
+			}
+			class := strings.TrimSpace(tokenClasses[start+runStart] + " " + coverageClasses[start+runStart])
+			text := html.EscapeString(line[runStart:j])
+			if class == "" {
+				lineHTML.WriteString(text)
+			} else {
+				lineHTML.WriteString(`<span class="` + class + `">` + text + `</span>`)
+			}
+			runStart = j
+		}
+		coverageLines = append(coverageLines, &coverageLine{Number: i + 1, HTML: template.HTML(lineHTML.String())})
+	}
+	return coverageLines
+}
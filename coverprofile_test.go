@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const coverageTestSource = `package foo
+
+// Sign returns the sign of n.
+func Sign(n int) string {
+	if n < 0 {
+		return "negative"
+	}
+	return "positive"
+}
+`
+
+func TestReadCoverageProfile(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report-coverprofile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "sign.go"), []byte(coverageTestSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+	profile := `mode: set
+example.com/foo/sign.go:4.25,5.11 1 1
+example.com/foo/sign.go:5.11,7.3 1 0
+example.com/foo/sign.go:8.2,8.19 1 1
+example.com/foo/sign.go:5.11,7.3 1 1
+example.invalid/bar/bar.go:3.14,5.2 2 0
+`
+	profileFile := filepath.Join(dir, "cover.out")
+	if err := ioutil.WriteFile(profileFile, []byte(profile), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := readCoverageProfile(profileFile, goListJSONByPackage{
+		"example.com/foo": {ImportPath: "example.com/foo", Dir: dir},
+	})
+	assertions.Nil(err)
+	assertions.Len(files, 2)
+
+	// the duplicate block of the merged profile covers the if statement
+	foo := files[0]
+	assertions.Equal("example.com/foo/sign.go", foo.Name)
+	assertions.Equal("example.com/foo", foo.Package)
+	assertions.Equal("sign.go", foo.FileName())
+	assertions.Equal("coverage-0", foo.ID)
+	assertions.Equal(3, foo.Statements)
+	assertions.Equal(3, foo.CoveredStatements)
+	assertions.Equal(100.0, foo.Percent)
+	assertions.Len(foo.Lines, 9)
+
+	// the package of the file can not be resolved, the file is listed without its source
+	bar := files[1]
+	assertions.Equal("example.invalid/bar", bar.Package)
+	assertions.Equal(2, bar.Statements)
+	assertions.Equal(0.0, bar.Percent)
+	assertions.Empty(bar.Lines)
+
+	assertions.Nil(ioutil.WriteFile(profileFile, []byte("mode: set\nexample.com/foo/sign.go:4.25 1\n"), 0644))
+	_, err = readCoverageProfile(profileFile, goListJSONByPackage{})
+	assertions.EqualError(err, profileFile+`:2: invalid coverage profile line "example.com/foo/sign.go:4.25 1"`)
+}
+
+func TestAnnotateSource(t *testing.T) {
+	assertions := assert.New(t)
+	lines := annotateSource([]byte(coverageTestSource), []*coverageBlock{
+		{StartLine: 4, StartCol: 25, EndLine: 5, EndCol: 11, NumStmt: 1, Count: 1},
+		{StartLine: 5, StartCol: 11, EndLine: 7, EndCol: 3, NumStmt: 1, Count: 0},
+		{StartLine: 8, StartCol: 2, EndLine: 8, EndCol: 19, NumStmt: 1, Count: 1},
+	})
+	assertions.Len(lines, 9)
+	assertions.Equal(1, lines[0].Number)
+	assertions.Equal(template.HTML(`<span class="keyword">package</span> foo`), lines[0].HTML)
+	assertions.Equal(template.HTML(""), lines[1].HTML)
+	assertions.Equal(template.HTML(`<span class="comment">// Sign returns the sign of n.</span>`), lines[2].HTML)
+	assertions.Equal(template.HTML(`<span class="keyword">func</span> Sign(n int) string <span class="covered">{</span>`), lines[3].HTML)
+	assertions.Equal(template.HTML(`<span class="covered">`+"\t"+`</span><span class="keyword covered">if</span>`+
+		`<span class="covered"> n &lt; </span><span class="number covered">0</span><span class="covered"> </span>`+
+		`<span class="uncovered">{</span>`), lines[4].HTML)
+	assertions.Equal(template.HTML(`<span class="uncovered">`+"\t\t"+`</span><span class="keyword uncovered">return</span>`+
+		`<span class="uncovered"> </span><span class="string uncovered">&#34;negative&#34;</span>`), lines[5].HTML)
+	assertions.Equal(template.HTML("\t"+`<span class="keyword covered">return</span><span class="covered"> </span>`+
+		`<span class="string covered">&#34;positive&#34;</span>`), lines[7].HTML)
+}
+
+func TestAnnotateSourceWithBlocksOutsideOfTheSource(t *testing.T) {
+	assertions := assert.New(t)
+	// the blocks of an outdated or truncated profile, which are ignored
+	lines := annotateSource([]byte(coverageTestSource), []*coverageBlock{
+		{StartLine: 1, StartCol: 0, EndLine: 1, EndCol: 8, NumStmt: 1, Count: 1},
+		{StartLine: 8, StartCol: 2, EndLine: 12, EndCol: 2, NumStmt: 1, Count: 1},
+		{StartLine: 4, StartCol: 80, EndLine: 5, EndCol: 11, NumStmt: 1, Count: 1},
+		{StartLine: 5, StartCol: 11, EndLine: 7, EndCol: 40, NumStmt: 1, Count: 0},
+		{StartLine: 7, StartCol: 1, EndLine: 5, EndCol: 1, NumStmt: 1, Count: 0},
+		{StartLine: 0, StartCol: 1, EndLine: 1, EndCol: 1, NumStmt: 1, Count: 0},
+	})
+	assertions.Len(lines, 9)
+	for _, line := range lines {
+		assertions.NotContains(string(line.HTML), "covered")
+	}
+	assertions.Equal(template.HTML(`<span class="keyword">package</span> foo`), lines[0].HTML)
+
+	// the end column of a block is the column following the last character of the block
+	lines = annotateSource([]byte(coverageTestSource), []*coverageBlock{
+		{StartLine: 1, StartCol: 1, EndLine: 1, EndCol: 12, NumStmt: 1, Count: 1},
+	})
+	assertions.Equal(template.HTML(`<span class="keyword covered">package</span><span class="covered"> foo</span>`), lines[0].HTML)
+}
+
+func TestGenerateReportWithCoverageProfile(t *testing.T) {
+	assertions := assert.New(t)
+	file := &coverageFile{
+		Name:              "example.com/foo/sign.go",
+		Package:           "example.com/foo",
+		ID:                "coverage-0",
+		Statements:        3,
+		CoveredStatements: 2,
+		Percent:           200.0 / 3,
+		Lines:             []*coverageLine{{Number: 1, HTML: `<span class="keyword">package</span> foo`}},
+	}
+	tmplData := &templateData{numOfTestsPerGroup: 20, CoverageFiles: []*coverageFile{file}}
+	allPackages := map[string]*packageStatus{
+		"example.com/foo": {Name: "example.com/foo", Passed: true, CoverageFiles: []*coverageFile{file}},
+	}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, map[string]*testStatus{}, allPackages, testFileDetailsByPackage{}, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Contains(buffer.String(), `<a class="coverageLink" href="#coverage-0">sign.go (66.7%)</a>`)
+	assertions.Contains(buffer.String(), `<details class="coverageFile" id="coverage-0">`)
+	assertions.Contains(buffer.String(), `66.7% (2 of 3 statements)`)
+	assertions.Contains(buffer.String(), `<span class="lineNumber">1</span><span class="keyword">package</span> foo`)
+}
@@ -1,5 +1,5 @@
 package main
 
-var testReportHTMLTemplate = `3c21444f43545950452068746d6c3e0a3c68746d6c206c616e673d22656e223e0a3c686561643e0a202020203c6d65746120636861727365743d225554462d38223e0a202020203c7469746c653e7b7b2e5265706f72745469746c657d7d3c2f7469746c653e0a202020203c7374796c6520747970653d22746578742f637373223e0a2020202020202020626f6479207b0a202020202020202020202020666f6e742d66616d696c793a2073616e732d73657269663b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236633663366333b0a202020202020202020202020626f726465722d746f703a20327078202364656536653820736f6c69643b0a2020202020202020202020206d617267696e3a20303b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572207370616e2e70726f6a6563745469746c65207b0a202020202020202020202020666f6e742d66616d696c793a2073657269663b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a20202020202020202020202070616464696e672d6c6566743a20353670783b0a20202020202020202020202070616464696e672d746f703a20383070783b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020636f6c6f723a20236135613561353b0a202020202020202020202020746578742d736861646f773a2030202d317078203170782077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203770783b0a20202020202020202020202072696768743a20353270783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20236132613261323b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e696e64696361746f72207b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020746f703a203570783b0a202020202020202020202020746578742d736861646f773a20302031707820302077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207374726f6e67207b0a2020202020202020202020206d617267696e2d72696768743a20313670783b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e746f74616c207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233832393861663b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e706173736564207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233666636138333b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e736b6970706564207b0a2020202020202020202020206261636b67726f756e643a20236261626162613b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e6661696c6564207b0a2020202020202020202020206261636b67726f756e643a20236666373637363b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207b0a2020202020202020202020206d617267696e2d72696768743a203170783b0a2020202020202020202020206865696768743a20353570783b0a20202020202020202020202070616464696e673a20323070782038707820313870783b0a202020202020202020202020636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e7465737447726f7570735469746c65207b0a2020202020202020202020206d617267696e3a203136707820333270782038707820343070783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e74657374457865637574696f6e44617465207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a20202020202020202020202072696768743a20313070783b0a2020202020202020202020206d617267696e3a203134707820333270782038707820343070783b0a202020202020202020202020636f6c6f723a20233965396539653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e746573745265706f7274436f6e7461696e6572207b0a20202020202020202020202070616464696e673a20302033327078203332707820333270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572207b0a20202020202020202020202070616464696e673a2031367078203136707820313670783b0a202020202020202020202020626f782d736861646f773a2030203470782034707820236434643464343b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020202374657374526573756c7473207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020666c65782d777261703a20777261703b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f7570207b0a20202020202020202020202077696474683a207b7b2e54657374526573756c7447726f7570496e64696361746f7257696474687d7d3b0a2020202020202020202020206865696768743a207b7b2e54657374526573756c7447726f7570496e64696361746f724865696768747d7d3b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233433633134333b0a2020202020202020202020206d617267696e2d6c6566743a203170783b0a2020202020202020202020206d617267696e2d626f74746f6d3a203170783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e73656c6563746564207b0a202020202020202020202020626f726465723a2031707820776869746520736f6c69643b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20626c61636b2021696d706f7274616e743b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e736b6970706564207b0a202020202020202020202020626f726465723a20327078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e6661696c6564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c6973742c0a20202020202020202e63617264436f6e7461696e65722e7465737444657461696c207b0a2020202020202020202020206d617267696e2d746f703a20313670783b0a20202020202020202020202070616464696e673a20313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374207b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020637572736f723a2064656661756c743b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e74657374537461747573207b0a202020202020202020202020666f6e742d73697a653a20312e32656d3b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233133396531333b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a202020202020202020202020666c6f61743a206c6566743b0a20202020202020202020202070616464696e672d746f703a20313070783b0a20202020202020202020202070616464696e672d6c6566743a20323070783b0a20202020202020202020202070616464696e672d72696768743a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745469746c65207b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020202020202070616464696e673a2031327078203020313070783b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020636f6c6f723a20233532353235323b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a20202020202020202020202077696474683a2063616c632831303025202d203131307078293b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573744475726174696f6e207b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020626f726465722d6c6566743a20347078202334336331343320736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a202020202020202020202020626f726465722d6c6566743a20347078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a202020202020202020202020626f726465722d6c6566743a203470782072656420736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f773a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a2020202020202020202020207472616e736974696f6e3a20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207b0a20202020202020202020202070616464696e673a203870782031367078203234707820313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020202020202070616464696e673a20313070783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233432343234323b0a202020202020202020202020636f6c6f723a20233161666630303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202331616666303020646f747465643b0a2020202020202020202020206f766572666c6f773a206175746f3b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c207b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364306430643020736f6c69643b0a20202020202020202020202070616464696e673a20313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a202020202020202020202020626f726465722d7261646975733a2030203020347078203470783b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e736b69707065647b0a202020202020202020202020636f6c6f723a20236439643964393b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e6661696c6564207b0a202020202020202020202020636f6c6f723a20236666623262323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744475726174696f6e207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203570783b0a20202020202020202020202072696768743a203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a20202020202020202020202070616464696e672d72696768743a203870783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a202020203c2f7374796c653e0a3c2f686561643e0a3c626f64793e0a3c64697620636c6173733d2270616765486561646572223e0a202020203c7370616e20636c6173733d2270726f6a6563745469746c65223e7b7b2e5265706f72745469746c657d7d3c2f7370616e3e0a202020203c64697620636c6173733d22746573745374617473223e0a20202020202020203c7370616e20636c6173733d22746f74616c223e3c7370616e20636c6173733d22696e64696361746f72223e26626f78626f783b3c2f7370616e3e20546f74616c3a203c7374726f6e673e7b7b2e4e756d4f6654657374737d7d3c2f7374726f6e673e4475726174696f6e3a203c7374726f6e673e7b7b2e546573744475726174696f6e7d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22706173736564223e3c7370616e20636c6173733d22696e64696361746f72223e26636865636b3b3c2f7370616e3e205061737365643a203c7374726f6e673e7b7b2e4e756d4f66546573745061737365647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22736b6970706564223e3c7370616e20636c6173733d22696e64696361746f72223e26646173683b3c2f7370616e3e20536b69707065643a203c7374726f6e673e7b7b2e4e756d4f6654657374536b69707065647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d226661696c6564223e3c7370616e20636c6173733d22696e64696361746f72223e2663726f73733b3c2f7370616e3e204661696c65643a203c7374726f6e673e7b7b2e4e756d4f66546573744661696c65647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e0a202020203c2f6469763e0a202020203c7370616e20636c6173733d227465737447726f7570735469746c65223e546573742047726f7570733a3c2f7370616e3e0a202020203c7370616e20636c6173733d2274657374457865637574696f6e44617465223e7b7b2e54657374457865637574696f6e446174657d7d3c2f7370616e3e0a3c2f6469763e0a3c64697620636c6173733d22746573745265706f7274436f6e7461696e6572223e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572223e0a20202020202020203c6469762069643d2274657374526573756c7473223e0a2020202020202020202020207b7b72616e676520246b2c202476203a3d202e54657374526573756c74737d7d0a202020202020202020202020202020203c64697620636c6173733d2274657374526573756c7447726f7570207b7b2e4661696c757265496e64696361746f727d7d207b7b2e536b6970706564496e64696361746f727d7d222069643d227b7b246b7d7d223e3c2f6469763e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f6469763e0a202020203c2f6469763e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207465737447726f75704c697374222069643d227465737447726f75704c697374223e3c2f6469763e0a3c2f6469763e0a3c73637269707420747970653d226170706c69636174696f6e2f6a617661736372697074223e0a202020207b7b2e4a73436f64657d7d0a0a202020202f2a2a0a20202020202a204074797065207b54657374526573756c74737d0a20202020202a2f0a20202020636f6e73742064617461203d207b7b2e54657374526573756c74737d7d0a0a20202020636f6e7374207265706f7274203d2077696e646f772e476f546573745265706f7274287b0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020646174613a20646174612c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202074657374526573756c7473456c656d3a20646f63756d656e742e676574456c656d656e7442794964282774657374526573756c747327292c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c697374456c656d3a20646f63756d656e742e676574456c656d656e744279496428277465737447726f75704c69737427290a2020202020202020202020202020202020202020202020202020202020202020202020202020207d293b0a0a0a3c2f7363726970743e0a3c2f626f64793e0a3c2f68746d6c3e0a`
+var testReportHTMLTemplate = `3c21444f43545950452068746d6c3e0a3c68746d6c206c616e673d22656e223e0a3c686561643e0a202020203c6d65746120636861727365743d225554462d38223e0a202020203c7469746c653e7b7b2e5265706f72745469746c657d7d3c2f7469746c653e0a202020203c7374796c6520747970653d22746578742f637373223e0a2020202020202020626f6479207b0a202020202020202020202020666f6e742d66616d696c793a2073616e732d73657269663b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236633663366333b0a202020202020202020202020626f726465722d746f703a20327078202364656536653820736f6c69643b0a2020202020202020202020206d617267696e3a20303b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572207370616e2e70726f6a6563745469746c65207b0a202020202020202020202020666f6e742d66616d696c793a2073657269663b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a20202020202020202020202070616464696e672d6c6566743a20353670783b0a20202020202020202020202070616464696e672d746f703a20383070783b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020636f6c6f723a20236135613561353b0a202020202020202020202020746578742d736861646f773a2030202d317078203170782077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203770783b0a20202020202020202020202072696768743a20353270783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20236132613261323b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e696e64696361746f72207b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020746f703a203570783b0a202020202020202020202020746578742d736861646f773a20302031707820302077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207374726f6e67207b0a2020202020202020202020206d617267696e2d72696768743a20313670783b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e746f74616c207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233832393861663b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e706173736564207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233666636138333b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e736b6970706564207b0a2020202020202020202020206261636b67726f756e643a20236261626162613b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e6661696c6564207b0a2020202020202020202020206261636b67726f756e643a20236666373637363b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e696e636f6d706c657465207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233962366263633b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e666c616b79207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20236530613433613b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e6661696c65645061636b61676573207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20236438346634663b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e636f766572616765207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233561396264353b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e636f7665726167652e62656c6f774d696e696d756d207b0a2020202020202020202020206261636b67726f756e643a20236438346634663b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207b0a2020202020202020202020206d617267696e2d72696768743a203170783b0a2020202020202020202020206865696768743a20353570783b0a20202020202020202020202070616464696e673a20323070782038707820313870783b0a202020202020202020202020636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e74657374436f6d6d616e64207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a20202020202020202020202070616464696e672d6c6566743a20353870783b0a20202020202020202020202070616464696e672d746f703a203470783b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20236135613561353b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e7465737447726f7570735469746c65207b0a2020202020202020202020206d617267696e3a203136707820333270782038707820343070783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e74657374457865637574696f6e44617465207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a20202020202020202020202072696768743a20313070783b0a2020202020202020202020206d617267696e3a203134707820333270782038707820343070783b0a202020202020202020202020636f6c6f723a20233965396539653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e746573745265706f7274436f6e7461696e6572207b0a20202020202020202020202070616464696e673a20302033327078203332707820333270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572207b0a20202020202020202020202070616464696e673a2031367078203136707820313670783b0a202020202020202020202020626f782d736861646f773a2030203470782034707820236434643464343b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020202374657374526573756c7473207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020666c65782d777261703a20777261703b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f7570207b0a20202020202020202020202077696474683a207b7b2e54657374526573756c7447726f7570496e64696361746f7257696474687d7d3b0a2020202020202020202020206865696768743a207b7b2e54657374526573756c7447726f7570496e64696361746f724865696768747d7d3b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233433633134333b0a2020202020202020202020206d617267696e2d6c6566743a203170783b0a2020202020202020202020206d617267696e2d626f74746f6d3a203170783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e73656c6563746564207b0a202020202020202020202020626f726465723a2031707820776869746520736f6c69643b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20626c61636b2021696d706f7274616e743b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e736b6970706564207b0a202020202020202020202020626f726465723a20327078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e696e636f6d706c657465207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233962366263633b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e6661696c6564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c6973742c0a20202020202020202e63617264436f6e7461696e65722e7465737444657461696c207b0a2020202020202020202020206d617267696e2d746f703a20313670783b0a20202020202020202020202070616464696e673a20313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374207b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020637572736f723a2064656661756c743b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e74657374537461747573207b0a202020202020202020202020666f6e742d73697a653a20312e32656d3b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233133396531333b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a202020202020202020202020666c6f61743a206c6566743b0a20202020202020202020202070616464696e672d746f703a20313070783b0a20202020202020202020202070616464696e672d6c6566743a20323070783b0a20202020202020202020202070616464696e672d72696768743a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745469746c65207b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020202020202070616464696e673a2031327078203020313070783b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020636f6c6f723a20233532353235323b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a20202020202020202020202077696474683a2063616c632831303025202d203131307078293b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573744475726174696f6e207b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e6661696c75726553756d6d617279207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a2020202020202020202020206d617267696e3a202d367078203131307078203020303b0a20202020202020202020202070616464696e673a20302030203130707820343870783b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20302e3835656d3b0a202020202020202020202020636f6c6f723a20236438346634663b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020626f726465722d6c6566743a20347078202334336331343320736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a202020202020202020202020626f726465722d6c6566743a20347078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a202020202020202020202020626f726465722d6c6566743a203470782072656420736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e696e636f6d706c657465207b0a202020202020202020202020636f6c6f723a20233962366263633b0a202020202020202020202020626f726465722d6c6566743a20347078202339623662636320736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e696e636f6d706c657465207b0a202020202020202020202020636f6c6f723a20233962366263633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e74696d65646f75742c0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e74696d65646f7574207b0a202020202020202020202020636f6c6f723a20236439373330643b0a202020202020202020202020626f726465722d6c6566742d636f6c6f723a20236439373330643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e73756274657374546f67676c65207b0a202020202020202020202020666c6f61743a206c6566743b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020202020202070616464696e673a203130707820387078203020303b0a2020202020202020202020207472616e736974696f6e3a207472616e73666f726d20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e73756274657374546f67676c652e657870616e646564207b0a2020202020202020202020207472616e73666f726d3a20726f74617465283930646567293b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207b0a2020202020202020202020206d617267696e2d6c6566743a20313270783b0a202020202020202020202020666f6e742d73697a653a20302e3835656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207370616e207b0a2020202020202020202020206d617267696e2d72696768743a203670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207370616e2e706173736564207b0a202020202020202020202020636f6c6f723a20233133396531333b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207370616e2e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207370616e2e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e7375627465737453756d6d617279207370616e2e696e636f6d706c657465207b0a202020202020202020202020636f6c6f723a20233962366263633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e737562746573744c697374207b0a2020202020202020202020206d617267696e2d6c6566743a20323470783b0a202020202020202020202020626f726465722d6c6566743a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e737562746573744c6973742e636f6c6c6170736564207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f773a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a2020202020202020202020207472616e736974696f6e3a20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207b0a20202020202020202020202070616464696e673a203870782031367078203234707820313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e666c616b794261646765207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a20202020202020202020202070616464696e673a20317078203670783b0a202020202020202020202020626f726465722d7261646975733a203370783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236530613433613b0a202020202020202020202020636f6c6f723a2077686974653b0a202020202020202020202020666f6e742d73697a653a20302e3735656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e726163654261646765207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a20202020202020202020202070616464696e673a20317078203670783b0a202020202020202020202020626f726465722d7261646975733a203370783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236330333932623b0a202020202020202020202020636f6c6f723a2077686974653b0a202020202020202020202020666f6e742d73697a653a20302e3735656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e66757a7a4261646765207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a20202020202020202020202070616464696e673a20317078203670783b0a202020202020202020202020626f726465722d7261646975733a203370783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233832393861663b0a202020202020202020202020636f6c6f723a2077686974653b0a202020202020202020202020666f6e742d73697a653a20302e3735656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e66757a7a4661696c757265207b0a20202020202020202020202070616464696e673a203132707820313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666663366333b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e66757a7a4661696c757265207072652e696e707574207b0a2020202020202020202020206d617267696e3a2038707820303b0a20202020202020202020202070616464696e673a203870783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a2077686974653b0a202020202020202020202020626f726465723a20317078202366666232623220736f6c69643b0a2020202020202020202020206f766572666c6f773a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e66757a7a4661696c757265202e726570726f436f6d6d616e64207b0a2020202020202020202020206d617267696e2d746f703a203470783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e6963207b0a20202020202020202020202070616464696e673a203132707820313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666663366333b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e6963202e70616e696356616c7565207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20312e33656d3b0a202020202020202020202020636f6c6f723a20236438346634663b0a20202020202020202020202077686974652d73706163653a207072652d777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7261636573207b0a20202020202020202020202070616464696e673a2038707820313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666663366333b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e72616365732061207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a202020202020202020202020636f6c6f723a20236330333932623b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c75726573207b0a20202020202020202020202070616464696e673a203132707820313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666663366333b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202b202e746573746966794661696c757265207b0a2020202020202020202020206d617267696e2d746f703a20313270783b0a20202020202020202020202070616464696e672d746f703a20313270783b0a202020202020202020202020626f726465722d746f703a20317078202366666232623220736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e746573746966794572726f72207b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20312e33656d3b0a202020202020202020202020636f6c6f723a20236438346634663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c75726520707265207b0a2020202020202020202020206d617267696e3a2034707820303b0a20202020202020202020202070616464696e673a203870783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a2077686974653b0a202020202020202020202020626f726465723a20317078202366666232623220736f6c69643b0a2020202020202020202020206f766572666c6f773a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e7465737469667956616c756573207b0a202020202020202020202020646973706c61793a20666c65783b0a2020202020202020202020206d617267696e2d746f703a203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e7465737469667956616c756573203e20646976207b0a202020202020202020202020666c65783a20313b0a2020202020202020202020206d696e2d77696474683a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e7465737469667956616c756573203e20646976202b20646976207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265207072652e64696666207370616e2e646966664c696e652e686561646572207b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265207072652e64696666207370616e2e646966664c696e652e68756e6b207b0a202020202020202020202020636f6c6f723a20233832393861663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265207072652e64696666207370616e2e646966664c696e652e72656d6f766564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666653265323b0a202020202020202020202020636f6c6f723a20236330333932623b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265207072652e64696666207370616e2e646966664c696e652e6164646564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236532663565323b0a202020202020202020202020636f6c6f723a20233265376433323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e6572726f725472616365207b0a2020202020202020202020206d617267696e2d746f703a203470783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e746573746966794661696c757265202e6572726f725472616365202e74726163654c6f636174696f6e207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e69632064657461696c732e676f726f7574696e652073756d6d6172792c0a20202020202020202e63617264436f6e7461696e65722e726163654c6973742064657461696c732073756d6d617279207b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e6963202e737461636b4672616d652c0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e737461636b4672616d65207b0a20202020202020202020202070616464696e673a2032707820302032707820313670783b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e6963202e737461636b4672616d65207370616e2e6c6f636174696f6e2c0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e737461636b4672616d65207370616e2e6c6f636174696f6e207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a20202020202020202020202070616464696e672d6c6566743a20313670783b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e6963202e737461636b4672616d652e696e4d6f64756c652c0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e737461636b4672616d652e696e4d6f64756c65207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666653265323b0a202020202020202020202020636f6c6f723a20233432343234323b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e70616e69632064657461696c732e72756e74696d654672616d65732073756d6d6172792c0a20202020202020202e63617264436f6e7461696e65722e726163654c6973742064657461696c732e72756e74696d654672616d65732073756d6d617279207b0a20202020202020202020202070616464696e672d6c6566743a20313670783b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020636f6c6f723a20233963396339633b0a202020202020202020202020666f6e742d7374796c653a206974616c69633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617474656d707454616273207b0a202020202020202020202020646973706c61793a20666c65783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617474656d707454616273207370616e2e617474656d7074546162207b0a20202020202020202020202070616464696e673a2034707820313270783b0a2020202020202020202020206d617267696e2d72696768743a203170783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020626f726465722d7261646975733a2034707820347078203020303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617474656d707454616273207370616e2e617474656d70745461622e6661696c6564207b0a202020202020202020202020636f6c6f723a20236438346634663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617474656d707454616273207370616e2e617474656d70745461622e696e636f6d706c657465207b0a202020202020202020202020636f6c6f723a20233962366263633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617474656d707454616273207370616e2e617474656d70745461622e73656c6563746564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233432343234323b0a202020202020202020202020636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020202020202070616464696e673a20313070783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233432343234323b0a202020202020202020202020636f6c6f723a20233161666630303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202331616666303020646f747465643b0a2020202020202020202020206f766572666c6f773a206175746f3b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c207b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364306430643020736f6c69643b0a20202020202020202020202070616464696e673a20313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a202020202020202020202020626f726465722d7261646975733a2030203020347078203470783b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207370616e2e7261774f7574707574546f67676c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a20202020202020202020202070616464696e673a2034707820313270783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233432343234323b0a202020202020202020202020636f6c6f723a20236439643964393b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020746578742d6465636f726174696f6e3a20756e6465726c696e653b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207370616e2e646966664c696e652e72656d6f7665642c0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207370616e2e6469666656616c75652e77616e74207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233563336133613b0a202020202020202020202020636f6c6f723a20236666386638663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207370616e2e646966664c696e652e61646465642c0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207370616e2e6469666656616c75652e676f74207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233361356333613b0a202020202020202020202020636f6c6f723a20233866666638663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65206d61726b207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666663539643b0a202020202020202020202020636f6c6f723a20233432343234323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e736b69707065647b0a202020202020202020202020636f6c6f723a20236439643964393b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e6661696c6564207b0a202020202020202020202020636f6c6f723a20236666623262323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744475726174696f6e207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203570783b0a20202020202020202020202072696768743a203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a20202020202020202020202070616464696e672d72696768743a203870783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a0a20202020202020202e746573745265706f7274436f6e7461696e6572202e7061636b616765735469746c65207b0a2020202020202020202020206d617267696e3a2032347078203020387078203870783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020646973706c61793a20626c6f636b3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374207b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a202020202020202020202020626f726465722d6c6566743a20347078202334336331343320736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772e736b6970706564207b0a202020202020202020202020626f726465722d6c6566743a20347078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772e6661696c6564207b0a202020202020202020202020626f726465722d6c6566743a203470782072656420736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772073756d6d617279207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020637572736f723a2064656661756c743b0a2020202020202020202020206c6973742d7374796c653a206e6f6e653b0a20202020202020202020202070616464696e673a20313070782030203130707820323070783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772073756d6d6172793a3a2d7765626b69742d64657461696c732d6d61726b6572207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772073756d6d6172793a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a2020202020202020202020207472616e736974696f6e3a20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b616765537461747573207b0a202020202020202020202020666f6e742d73697a653a20312e32656d3b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233133396531333b0a20202020202020202020202070616464696e672d72696768743a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772e736b6970706564207370616e2e7061636b616765537461747573207b0a202020202020202020202020636f6c6f723a20677261793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772e6661696c6564207370616e2e7061636b616765537461747573207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b6167654e616d65207b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b6167654e6f7465207b0a2020202020202020202020206d617267696e2d6c6566743a20313270783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020666f6e742d7374796c653a206974616c69633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b616765536f7572636573207b0a2020202020202020202020206d617267696e2d6c6566743a20313270783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f772e6661696c6564207370616e2e7061636b6167654e6f7465207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e746573744475726174696f6e207b0a202020202020202020202020746f703a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b616765436f766572616765207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a20313270783b0a20202020202020202020202072696768743a2031323070783b0a20202020202020202020202077696474683a20363470783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77207370616e2e7061636b616765436f7665726167652e62656c6f774d696e696d756d207b0a202020202020202020202020636f6c6f723a207265643b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7261774f7574707574207b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7261774f7574707574202e636f6e736f6c65207b0a2020202020202020202020206d617267696e3a20303b0a202020202020202020202020636f6c6f723a20236439643964393b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7261774f7574707574202e636f6e736f6c65207370616e2e6c696e654e756d626572207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a2020202020202020202020206d696e2d77696474683a20343870783b0a2020202020202020202020206d617267696e2d72696768743a20313270783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020636f6c6f723a20233963396339633b0a202020202020202020202020757365722d73656c6563743a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e636f6e736f6c65207b0a2020202020202020202020206d617267696e3a203870782031367078203136707820313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e72756e6e696e675465737473207b0a2020202020202020202020206d617267696e3a2038707820313670783b0a202020202020202020202020636f6c6f723a20236439373330643b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e72756e6e696e67546573747320756c207b0a2020202020202020202020206d617267696e3a2034707820303b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e636f76657261676546696c6573207b0a2020202020202020202020206d617267696e3a2038707820313670783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e636f76657261676546696c65732061207b0a2020202020202020202020206d617267696e2d6c6566743a20313270783b0a202020202020202020202020636f6c6f723a20233362376462643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e65207b0a2020202020202020202020206d617267696e3a2038707820313670783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274207b0a2020202020202020202020206d617267696e2d746f703a203470783b0a2020202020202020202020206f766572666c6f772d783a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e6543686172742074657874207b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20313170783b0a20202020202020202020202066696c6c3a20233432343234323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e65436861727420746578742e74696d656c696e655469636b4c6162656c207b0a20202020202020202020202066696c6c3a20233963396339633b0a202020202020202020202020746578742d616e63686f723a206d6964646c653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274206c696e652e74696d656c696e655469636b207b0a2020202020202020202020207374726f6b653a20236536653665363b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e65436861727420726563742e74696d656c696e655370616e207b0a20202020202020202020202066696c6c3a20236230623062303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274202e74696d656c696e65526f772e7061737320726563742e74696d656c696e65426172207b0a20202020202020202020202066696c6c3a20233133396531333b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274202e74696d656c696e65526f772e6661696c20726563742e74696d656c696e65426172207b0a20202020202020202020202066696c6c3a20236438346634663b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274202e74696d656c696e65526f772e736b697020726563742e74696d656c696e65426172207b0a20202020202020202020202066696c6c3a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b6167654c697374202e7061636b616765526f77202e74696d656c696e654368617274202e74696d656c696e65526f772e696e636f6d706c65746520726563742e74696d656c696e65426172207b0a20202020202020202020202066696c6c3a20233962366263633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374207b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f76657261676546696c65207b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f76657261676546696c652073756d6d617279207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020202020202070616464696e673a20313070782030203130707820323070783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f76657261676546696c652073756d6d6172793a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a2020202020202020202020207472616e736974696f6e3a20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f76657261676546696c65207370616e2e636f76657261676550657263656e74207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a20202020202020202020202072696768743a20313670783b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f76657261676546696c65207370616e2e736f757263654e6f74466f756e64207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a2020202020202020202020206d617267696e3a20302031367078203132707820333670783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020666f6e742d7374796c653a206974616c69633b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365207b0a2020202020202020202020206d617267696e3a20303b0a20202020202020202020202070616464696e673a2038707820303b0a2020202020202020202020206f766572666c6f773a206175746f3b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236662666266623b0a202020202020202020202020636f6c6f723a20233361336133613b0a2020202020202020202020207461622d73697a653a20343b0a202020202020202020202020666f6e742d73697a653a20302e3835656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365207370616e2e6c696e654e756d626572207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a2020202020202020202020206d696e2d77696474683a20343870783b0a2020202020202020202020206d617267696e2d72696768743a20313270783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020636f6c6f723a20236230623062303b0a202020202020202020202020757365722d73656c6563743a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e636f7665726564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236437663564633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e756e636f7665726564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236662643964393b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e6b6579776f7264207b0a202020202020202020202020636f6c6f723a20233062333561383b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e737472696e67207b0a202020202020202020202020636f6c6f723a20233161376633373b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e6e756d626572207b0a202020202020202020202020636f6c6f723a20233962346463613b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e636f7665726167654c697374202e636f766572616765536f75726365202e636f6d6d656e74207b0a202020202020202020202020636f6c6f723a20233861386138613b0a202020202020202020202020666f6e742d7374796c653a206974616c69633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e726163654c697374207b0a20202020202020202020202070616464696e673a20303b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a2064696d677265793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e6461746152616365207b0a20202020202020202020202070616464696e673a203132707820313670783b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a202020202020202020202020626f726465722d6c6566743a20347078202363303339326220736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e6461746152616365202e726163655469746c65207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203470783b0a202020202020202020202020666f6e742d73697a653a20312e32656d3b0a202020202020202020202020636f6c6f723a20236330333932623b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e6461746152616365202e726163654c6f636174696f6e207b0a2020202020202020202020206d617267696e2d6c6566743a20313270783b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e726163654c697374202e6461746152616365202e726163655465737473207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d617279207b0a20202020202020202020202070616464696e673a20303b0a2020202020202020202020206f766572666c6f773a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d617279207461626c65207b0a20202020202020202020202077696474683a20313030253b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d617279207468207b0a20202020202020202020202070616464696e673a203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020636f6c6f723a2064696d677265793b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d617279207464207b0a20202020202020202020202070616464696e673a20367078203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202365636563656320736f6c69643b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d6172792074666f6f74207464207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020626f726465722d626f74746f6d3a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d617279207370616e2e7061636b616765436f7665726167652e62656c6f774d696e696d756d207b0a202020202020202020202020636f6c6f723a207265643b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d6172792074682e746578742c0a20202020202020202e63617264436f6e7461696e65722e7061636b61676553756d6d6172792074642e74657874207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c697374207b0a20202020202020202020202070616464696e673a20303b0a2020202020202020202020206f766572666c6f773a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c697374207461626c65207b0a20202020202020202020202077696474683a20313030253b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c697374207468207b0a20202020202020202020202070616464696e673a203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020636f6c6f723a2064696d677265793b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020757365722d73656c6563743a206e6f6e653b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c6973742074682e617363656e64696e673a3a6166746572207b0a202020202020202020202020636f6e74656e743a2022205c32354234223b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c6973742074682e64657363656e64696e673a3a6166746572207b0a202020202020202020202020636f6e74656e743a2022205c32354245223b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c697374207464207b0a20202020202020202020202070616464696e673a20367078203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202365636563656320736f6c69643b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c6973742074682e746578742c0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c6973742074642e74657874207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e62656e63686d61726b4c6973742074626f64792074723a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a20202020202020207d0a202020203c2f7374796c653e0a3c2f686561643e0a3c626f64793e0a3c64697620636c6173733d2270616765486561646572223e0a202020203c7370616e20636c6173733d2270726f6a6563745469746c65223e7b7b2e5265706f72745469746c657d7d3c2f7370616e3e0a202020207b7b6966202e54657374436f6d6d616e647d7d3c7370616e20636c6173733d2274657374436f6d6d616e64223e7b7b2e54657374436f6d6d616e647d7d3c2f7370616e3e7b7b656e647d7d0a202020203c64697620636c6173733d22746573745374617473223e0a20202020202020203c7370616e20636c6173733d22746f74616c223e3c7370616e20636c6173733d22696e64696361746f72223e26626f78626f783b3c2f7370616e3e20546f74616c3a203c7374726f6e673e7b7b2e4e756d4f6654657374737d7d3c2f7374726f6e673e4475726174696f6e3a203c7374726f6e673e7b7b2e546573744475726174696f6e7d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22706173736564223e3c7370616e20636c6173733d22696e64696361746f72223e26636865636b3b3c2f7370616e3e205061737365643a203c7374726f6e673e7b7b2e4e756d4f66546573745061737365647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22736b6970706564223e3c7370616e20636c6173733d22696e64696361746f72223e26646173683b3c2f7370616e3e20536b69707065643a203c7374726f6e673e7b7b2e4e756d4f6654657374536b69707065647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d226661696c6564223e3c7370616e20636c6173733d22696e64696361746f72223e2663726f73733b3c2f7370616e3e204661696c65643a203c7374726f6e673e7b7b2e4e756d4f66546573744661696c65647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b6966202e4e756d4f6654657374496e636f6d706c6574657d7d3c7370616e20636c6173733d22696e636f6d706c657465223e3c7370616e20636c6173733d22696e64696361746f72223e3f3c2f7370616e3e20496e636f6d706c6574653a203c7374726f6e673e7b7b2e4e756d4f6654657374496e636f6d706c6574657d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b656e647d7d7b7b6966202e4e756d4f6654657374466c616b797d7d3c7370616e20636c6173733d22666c616b79223e3c7370616e20636c6173733d22696e64696361746f72223e2623383737363b3c2f7370616e3e20466c616b793a203c7374726f6e673e7b7b2e4e756d4f6654657374466c616b797d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b656e647d7d7b7b6966202e4e756d4f665061636b616765734661696c65647d7d3c7370616e20636c6173733d226661696c65645061636b61676573223e3c7370616e20636c6173733d22696e64696361746f72223e2663726f73733b3c2f7370616e3e204661696c6564205061636b616765733a203c7374726f6e673e7b7b2e4e756d4f665061636b616765734661696c65647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b656e647d7d7b7b77697468202e436f7665726167657d7d3c7370616e20636c6173733d22636f7665726167657b7b6966202e42656c6f774d696e696d756d7d7d2062656c6f774d696e696d756d7b7b656e647d7d223e3c7370616e20636c6173733d22696e64696361746f72223e253c2f7370616e3e20436f7665726167653a203c7374726f6e673e7b7b7072696e74662022252e316622202e50657263656e747d7d253c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b656e647d7d0a202020203c2f6469763e0a202020203c7370616e20636c6173733d227465737447726f7570735469746c65223e546573742047726f7570733a3c2f7370616e3e0a202020203c7370616e20636c6173733d2274657374457865637574696f6e44617465223e7b7b2e54657374457865637574696f6e446174657d7d3c2f7370616e3e0a3c2f6469763e0a3c64697620636c6173733d22746573745265706f7274436f6e7461696e6572223e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572223e0a20202020202020203c6469762069643d2274657374526573756c7473223e0a2020202020202020202020207b7b72616e676520246b2c202476203a3d202e54657374526573756c74737d7d0a202020202020202020202020202020203c64697620636c6173733d2274657374526573756c7447726f7570207b7b2e4661696c757265496e64696361746f727d7d207b7b2e496e636f6d706c657465496e64696361746f727d7d207b7b2e536b6970706564496e64696361746f727d7d222069643d227b7b246b7d7d223e3c2f6469763e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f6469763e0a202020203c2f6469763e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207465737447726f75704c697374222069643d227465737447726f75704c697374223e3c2f6469763e0a202020207b7b6966202e5061636b616765737d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e5061636b616765733a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207061636b6167654c697374222069643d227061636b6167654c697374223e0a20202020202020207b7b72616e6765202e5061636b616765737d7d0a2020202020202020202020203c64657461696c7320636c6173733d227061636b616765526f77207b7b6966202e5061737365647d7d7b7b656c7365206966202e536b69707065647d7d736b69707065647b7b656c73657d7d6661696c65647b7b656e647d7d223e0a202020202020202020202020202020203c73756d6d6172793e0a20202020202020202020202020202020202020203c7370616e20636c6173733d227061636b616765537461747573223e7b7b6966202e5061737365647d7d26636865636b3b7b7b656c7365206966202e536b69707065647d7d26646173683b7b7b656c73657d7d2663726f73733b7b7b656e647d7d3c2f7370616e3e0a20202020202020202020202020202020202020203c7370616e20636c6173733d227061636b6167654e616d65223e7b7b2e4e616d657d7d3c2f7370616e3e0a20202020202020202020202020202020202020203c7370616e20636c6173733d227061636b6167654e6f7465223e7b7b6966202e4275696c644661696c65647d7d6275696c64206661696c65647b7b656c7365206966202e54696d656f75747d7d74696d6564206f7574206166746572207b7b2e54696d656f75747d7d7b7b656c7365206966202e4e6f5465737446696c65737d7d6e6f20746573742066696c65737b7b656e647d7d3c2f7370616e3e0a20202020202020202020202020202020202020207b7b6966202e536f75726365737d7d3c7370616e20636c6173733d227061636b616765536f7572636573223e7b7b72616e67652024692c2024736f75726365203a3d202e536f75726365737d7d7b7b69662024697d7d2c207b7b656e647d7d7b7b24736f757263657d7d7b7b656e647d7d3c2f7370616e3e7b7b656e647d7d0a20202020202020202020202020202020202020207b7b77697468202e436f7665726167657d7d3c7370616e20636c6173733d227061636b616765436f7665726167657b7b6966202e42656c6f774d696e696d756d7d7d2062656c6f774d696e696d756d7b7b656e647d7d22207469746c653d227b7b6966202e42656c6f774d696e696d756d7d7d62656c6f7720746865206d696e696d756d20636f766572616765206f66207b7b242e4d696e436f7665726167657d7d257b7b656c73657d7d636f7665726167657b7b656e647d7d223e7b7b7072696e74662022252e316622202e50657263656e747d7d253c2f7370616e3e7b7b656e647d7d0a20202020202020202020202020202020202020203c7370616e20636c6173733d22746573744475726174696f6e223e3c7370616e3e7b7b2e456c617073656454696d657d7d73203c2f7370616e3ee28fb13c2f7370616e3e0a202020202020202020202020202020203c2f73756d6d6172793e0a202020202020202020202020202020207b7b6966202e4275696c644f75747075747d7d3c70726520636c6173733d22636f6e736f6c65206661696c6564223e7b7b72616e6765202e4275696c644f75747075747d7d7b7b2e7d7d7b7b656e647d7d3c2f7072653e7b7b656e647d7d0a202020202020202020202020202020207b7b6966202e54696d656f75747d7d3c64697620636c6173733d2272756e6e696e675465737473223e0a20202020202020202020202020202020202020203c7374726f6e673e52756e6e696e67207465737473207768656e207468652074696d656f7574206f66207b7b2e54696d656f75747d7d207761732065786365656465643a3c2f7374726f6e673e0a20202020202020202020202020202020202020207b7b6966202e52756e6e696e6754657374737d7d3c756c3e7b7b72616e6765202e52756e6e696e6754657374737d7d3c6c693e7b7b2e7d7d3c2f6c693e7b7b656e647d7d3c2f756c3e7b7b656c73657d7d3c7370616e3e6e6f74207265706f7274656420627920746869732076657273696f6e206f6620676f3c2f7370616e3e7b7b656e647d7d0a202020202020202020202020202020203c2f6469763e7b7b656e647d7d0a202020202020202020202020202020207b7b6966202e436f76657261676546696c65737d7d3c64697620636c6173733d22636f76657261676546696c6573223e0a20202020202020202020202020202020202020203c7374726f6e673e436f7665726167652070726f66696c653a3c2f7374726f6e673e0a20202020202020202020202020202020202020207b7b72616e6765202e436f76657261676546696c65737d7d3c6120636c6173733d22636f7665726167654c696e6b2220687265663d22237b7b2e49447d7d223e7b7b2e46696c654e616d657d7d20287b7b7072696e74662022252e316622202e50657263656e747d7d25293c2f613e7b7b656e647d7d0a202020202020202020202020202020203c2f6469763e7b7b656e647d7d0a202020202020202020202020202020207b7b77697468202474696d656c696e65203a3d202e54696d656c696e657d7d3c64697620636c6173733d2274696d656c696e65223e0a20202020202020202020202020202020202020203c7374726f6e673e54696d656c696e653a3c2f7374726f6e673e207b7b6c656e202e526f77737d7d207465737428732920696e207b7b2e4475726174696f6e7d7d0a20202020202020202020202020202020202020203c64697620636c6173733d2274696d656c696e654368617274223e0a2020202020202020202020202020202020202020202020203c7376672077696474683d227b7b2e57696474687d7d22206865696768743d227b7b2e4865696768747d7d222076696577426f783d22302030207b7b2e57696474687d7d207b7b2e4865696768747d7d223e0a202020202020202020202020202020202020202020202020202020207b7b72616e6765202e5469636b737d7d3c6c696e6520636c6173733d2274696d656c696e655469636b222078313d227b7b7072696e74662022252e316622202e587d7d222079313d2230222078323d227b7b7072696e74662022252e316622202e587d7d222079323d227b7b2474696d656c696e652e41786973597d7d222f3e0a202020202020202020202020202020202020202020202020202020203c7465787420636c6173733d2274696d656c696e655469636b4c6162656c2220783d227b7b7072696e74662022252e316622202e587d7d2220793d227b7b2474696d656c696e652e4865696768747d7d222064793d222d35223e7b7b2e4c6162656c7d7d3c2f746578743e7b7b656e647d7d0a202020202020202020202020202020202020202020202020202020207b7b72616e6765202e526f77737d7d3c6720636c6173733d2274696d656c696e65526f77207b7b2e5374617475737d7d22207472616e73666f726d3d227472616e736c61746528302c7b7b2e597d7d29223e0a20202020202020202020202020202020202020202020202020202020202020203c7465787420636c6173733d2274696d656c696e654c6162656c2220783d22342220793d223133223e7b7b2e4c6162656c7d7d3c7469746c653e7b7b2e546573744e616d657d7d3c2f7469746c653e3c2f746578743e0a20202020202020202020202020202020202020202020202020202020202020207b7b77697468202e5370616e7d7d3c7265637420636c6173733d2274696d656c696e655370616e2220783d227b7b7072696e74662022252e316622202e587d7d2220793d2238222077696474683d227b7b7072696e74662022252e316622202e57696474687d7d22206865696768743d2232223e3c7469746c653e7b7b2e5469746c657d7d3c2f7469746c653e3c2f726563743e7b7b656e647d7d0a20202020202020202020202020202020202020202020202020202020202020207b7b72616e6765202e426172737d7d3c7265637420636c6173733d2274696d656c696e654261722220783d227b7b7072696e74662022252e316622202e587d7d2220793d2233222077696474683d227b7b7072696e74662022252e316622202e57696474687d7d22206865696768743d223132223e3c7469746c653e7b7b2e5469746c657d7d3c2f7469746c653e3c2f726563743e7b7b656e647d7d0a202020202020202020202020202020202020202020202020202020203c2f673e7b7b656e647d7d0a2020202020202020202020202020202020202020202020203c2f7376673e0a20202020202020202020202020202020202020203c2f6469763e0a202020202020202020202020202020203c2f6469763e7b7b656e647d7d0a202020202020202020202020202020203c70726520636c6173733d22636f6e736f6c65207b7b6966202e5061737365647d7d7b7b656c7365206966202e536b69707065647d7d736b69707065647b7b656c73657d7d6661696c65647b7b656e647d7d223e7b7b72616e6765202e4f75747075747d7d7b7b2e7d7d7b7b656e647d7d3c2f7072653e0a2020202020202020202020203c2f64657461696c733e0a20202020202020207b7b656e647d7d0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e436f7665726167657d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e5061636b6167652053756d6d6172793a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207061636b61676553756d6d617279223e0a20202020202020203c7461626c652069643d227061636b61676553756d6d6172795461626c65223e0a2020202020202020202020203c74686561643e0a2020202020202020202020203c74723e0a202020202020202020202020202020203c746820636c6173733d2274657874223e5061636b6167653c2f74683e3c746820636c6173733d2274657874223e5374617475733c2f74683e3c74683e436f7665726167653c2f74683e3c74683e53746174656d656e74733c2f74683e3c74683e4475726174696f6e3c2f74683e0a2020202020202020202020203c2f74723e0a2020202020202020202020203c2f74686561643e0a2020202020202020202020203c74626f64793e0a2020202020202020202020207b7b72616e6765202e5061636b616765737d7d0a202020202020202020202020202020203c74723e0a20202020202020202020202020202020202020203c746420636c6173733d2274657874223e7b7b2e4e616d657d7d3c2f74643e0a20202020202020202020202020202020202020203c746420636c6173733d2274657874223e7b7b6966202e4275696c644661696c65647d7d6275696c64206661696c65647b7b656c7365206966202e54696d656f75747d7d74696d6564206f75747b7b656c7365206966202e5061737365647d7d7061737365647b7b656c7365206966202e536b69707065647d7d736b69707065647b7b656c73657d7d6661696c65647b7b656e647d7d3c2f74643e0a20202020202020202020202020202020202020203c74643e7b7b77697468202e436f7665726167657d7d3c7370616e20636c6173733d227061636b616765436f7665726167657b7b6966202e42656c6f774d696e696d756d7d7d2062656c6f774d696e696d756d7b7b656e647d7d223e7b7b7072696e74662022252e316622202e50657263656e747d7d253c2f7370616e3e7b7b656e647d7d3c2f74643e0a20202020202020202020202020202020202020203c74643e7b7b77697468202e436f7665726167657d7d7b7b6966202e53746174656d656e74737d7d7b7b2e53746174656d656e74737d7d7b7b656e647d7d7b7b656e647d7d3c2f74643e0a20202020202020202020202020202020202020203c74643e7b7b2e456c617073656454696d657d7d733c2f74643e0a202020202020202020202020202020203c2f74723e0a2020202020202020202020207b7b656e647d7d0a2020202020202020202020203c2f74626f64793e0a2020202020202020202020207b7b77697468202e436f7665726167657d7d3c74666f6f743e0a2020202020202020202020203c74723e0a202020202020202020202020202020203c746420636c6173733d2274657874223e546f74616c3c2f74643e3c74643e3c2f74643e0a202020202020202020202020202020203c74643e3c7370616e20636c6173733d227061636b616765436f7665726167657b7b6966202e42656c6f774d696e696d756d7d7d2062656c6f774d696e696d756d7b7b656e647d7d223e7b7b7072696e74662022252e316622202e50657263656e747d7d253c2f7370616e3e3c2f74643e0a202020202020202020202020202020203c74643e7b7b6966202e53746174656d656e74737d7d7b7b2e53746174656d656e74737d7d7b7b656e647d7d3c2f74643e3c74643e3c2f74643e0a2020202020202020202020203c2f74723e0a2020202020202020202020203c2f74666f6f743e7b7b656e647d7d0a20202020202020203c2f7461626c653e0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e52616365737d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e446174612052616365733a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e657220726163654c697374222069643d22726163654c697374223e3c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e42656e63686d61726b737d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e42656e63686d61726b733a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e65722062656e63686d61726b4c697374223e0a20202020202020203c7461626c652069643d2262656e63686d61726b5461626c65223e0a2020202020202020202020203c74686561643e0a2020202020202020202020203c74723e0a202020202020202020202020202020203c746820636c6173733d2274657874223e42656e63686d61726b3c2f74683e3c746820636c6173733d2274657874223e5061636b6167653c2f74683e3c74683e50726f63733c2f74683e3c74683e497465726174696f6e733c2f74683e0a202020202020202020202020202020207b7b72616e6765202e42656e63686d61726b556e6974737d7d3c74683e7b7b2e7d7d3c2f74683e7b7b656e647d7d0a202020202020202020202020202020203c746820636c6173733d2274657874223e4c6f636174696f6e3c2f74683e0a2020202020202020202020203c2f74723e0a2020202020202020202020203c2f74686561643e0a2020202020202020202020203c74626f64793e0a2020202020202020202020207b7b72616e6765202e42656e63686d61726b737d7d7b7b2462656e63686d61726b203a3d202e7d7d0a202020202020202020202020202020203c74723e0a20202020202020202020202020202020202020203c746420636c6173733d2274657874223e7b7b2e4e616d657d7d3c2f74643e3c746420636c6173733d2274657874223e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b6966202e50726f63737d7d7b7b2e50726f63737d7d7b7b656e647d7d3c2f74643e3c74643e7b7b2e497465726174696f6e737d7d3c2f74643e0a20202020202020202020202020202020202020207b7b72616e676520242e42656e63686d61726b556e6974737d7d3c74643e7b7b2462656e63686d61726b2e4d6574726963202e7d7d3c2f74643e7b7b656e647d7d0a20202020202020202020202020202020202020203c746420636c6173733d2274657874223e7b7b6966202e5465737446696c654e616d657d7d7b7b2e5465737446696c654e616d657d7d3a7b7b2e5465737446756e6374696f6e44657461696c2e4c696e657d7d7b7b656e647d7d3c2f74643e0a202020202020202020202020202020203c2f74723e0a2020202020202020202020207b7b656e647d7d0a2020202020202020202020203c2f74626f64793e0a20202020202020203c2f7461626c653e0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e436f76657261676546696c65737d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e436f7665726167652070726f66696c653a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e657220636f7665726167654c697374222069643d22636f7665726167654c697374223e0a20202020202020207b7b72616e6765202e436f76657261676546696c65737d7d0a2020202020202020202020203c64657461696c7320636c6173733d22636f76657261676546696c65222069643d227b7b2e49447d7d223e0a202020202020202020202020202020203c73756d6d6172793e0a20202020202020202020202020202020202020203c7370616e20636c6173733d22636f76657261676546696c654e616d65223e7b7b2e4e616d657d7d3c2f7370616e3e0a20202020202020202020202020202020202020203c7370616e20636c6173733d22636f76657261676550657263656e74223e7b7b7072696e74662022252e316622202e50657263656e747d7d2520287b7b2e436f766572656453746174656d656e74737d7d206f66207b7b2e53746174656d656e74737d7d2073746174656d656e7473293c2f7370616e3e0a202020202020202020202020202020203c2f73756d6d6172793e0a202020202020202020202020202020207b7b6966202e4c696e65737d7d3c70726520636c6173733d22636f766572616765536f75726365223e7b7b72616e6765202e4c696e65737d7d3c7370616e20636c6173733d226c696e654e756d626572223e7b7b2e4e756d6265727d7d3c2f7370616e3e7b7b2e48544d4c7d7d0a7b7b656e647d7d3c2f7072653e7b7b656c73657d7d3c7370616e20636c6173733d22736f757263654e6f74466f756e64223e736f757263652066696c65206e6f7420666f756e643c2f7370616e3e7b7b656e647d7d0a2020202020202020202020203c2f64657461696c733e0a20202020202020207b7b656e647d7d0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e5261774f75747075747d7d0a202020203c7370616e20636c6173733d227061636b616765735469746c65223e526177204f757470757420286c696e6573206f662074686520696e707574207468617420617265206e6f7420676f2074657374202d6a736f6e206576656e7473293a3c2f7370616e3e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207261774f7574707574222069643d227261774f7574707574223e0a20202020202020203c70726520636c6173733d22636f6e736f6c65223e7b7b72616e6765202e5261774f75747075747d7d3c7370616e20636c6173733d226c696e654e756d626572223e7b7b6966202e536f757263657d7d7b7b2e536f757263657d7d3a7b7b656e647d7d7b7b2e4c696e654e756d6265727d7d3c2f7370616e3e7b7b2e546578747d7d0a7b7b656e647d7d3c2f7072653e0a202020203c2f6469763e0a202020207b7b656e647d7d0a3c2f6469763e0a3c73637269707420747970653d226170706c69636174696f6e2f6a617661736372697074223e0a202020207b7b2e4a73436f64657d7d0a0a202020202f2a2a0a20202020202a204074797065207b54657374526573756c74737d0a20202020202a2f0a20202020636f6e73742064617461203d207b7b2e54657374526573756c74737d7d0a0a20202020636f6e7374207265706f7274203d2077696e646f772e476f546573745265706f7274287b0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020646174613a20646174612c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202074657374526573756c7473456c656d3a20646f63756d656e742e676574456c656d656e7442794964282774657374526573756c747327292c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c697374456c656d3a20646f63756d656e742e676574456c656d656e744279496428277465737447726f75704c69737427292c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202062656e63686d61726b5461626c65456c656d3a20646f63756d656e742e676574456c656d656e7442794964282762656e63686d61726b5461626c6527292c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020207061636b6167654c697374456c656d3a20646f63756d656e742e676574456c656d656e744279496428277061636b6167654c69737427292c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202072616365733a207b7b2e52616365737d7d2c0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020726163654c697374456c656d3a20646f63756d656e742e676574456c656d656e74427949642827726163654c69737427290a2020202020202020202020202020202020202020202020202020202020202020202020202020207d293b0a0a0a3c2f7363726970743e0a3c2f626f64793e0a3c2f68746d6c3e0a`
 
-var testReportJsCode = `2f2a2a0a202a20407479706564656620546573745374617475730a202a204070726f7065727479207b737472696e677d20546573744e616d650a202a204070726f7065727479207b737472696e677d205061636b6167650a202a204070726f7065727479207b6e756d6265727d20456c617073656454696d650a202a204070726f7065727479207b41727261792e3c737472696e673e7d204f75747075740a202a204070726f7065727479207b626f6f6c65616e7d205061737365640a202a204070726f7065727479207b626f6f6c65616e7d20536b69707065640a202a2f0a636c6173732054657374537461747573207b7d0a0a2f2a2a0a202a204074797065646566205465737447726f7570446174610a202a204074797065207b6f626a6563747d0a202a204070726f7065727479207b737472696e677d204661696c757265496e64696361746f720a202a204070726f7065727479207b737472696e677d20536b6970706564496e64696361746f720a202a204070726f7065727479207b41727261792e3c546573745374617475733e7d0a202a2f0a636c617373205465737447726f757044617461207b7d0a0a2f2a2a0a202a2040747970656465662054657374526573756c74730a202a204074797065207b41727261792e3c5465737447726f7570446174613e7d0a202a2f0a636c6173732054657374526573756c747320657874656e6473204172726179207b7d0a0a2f2a2a0a202a2040747970656465662053656c65637465644974656d730a202a204070726f7065727479207b48544d4c456c656d656e747c4576656e745461726765747d2074657374526573756c74730a202a204070726f7065727479207b537472696e677d2073656c65637465645465737447726f7570436f6c6f720a202a2f0a636c6173732053656c65637465644974656d73207b7d0a0a2f2a2a0a202a20407479706564656620476f546573745265706f7274456c656d656e74730a202a204070726f7065727479207b54657374526573756c74737d20646174610a202a204070726f7065727479207b48544d4c456c656d656e747d2074657374526573756c7473456c656d0a202a204070726f7065727479207b48544d4c456c656d656e747d207465737447726f75704c697374456c656d0a202a2f0a636c61737320476f546573745265706f7274456c656d656e7473207b7d0a0a0a2f2a2a0a202a204d61696e20656e74727920706f696e7420666f7220476f546573745265706f72742e0a202a2040706172616d207b476f546573745265706f7274456c656d656e74737d20656c656d656e74730a202a204072657475726e73207b7b74657374526573756c7473436c69636b48616e646c65723a2074657374526573756c7473436c69636b48616e646c65727d7d0a202a2040636f6e7374727563746f720a202a2f0a77696e646f772e476f546573745265706f7274203d2066756e6374696f6e2028656c656d656e747329207b0a2020636f6e7374202f2a2a4074797065207b53656c65637465644974656d737d2a2f2073656c65637465644974656d73203d207b0a2020202074657374526573756c74733a206e756c6c2c0a2020202073656c65637465645465737447726f7570436f6c6f723a206e756c6c0a20207d0a0a202066756e6374696f6e206164644576656e7444617461286576656e7429207b0a20202020696620286576656e742e64617461203d3d206e756c6c29207b0a2020202020206576656e742e64617461203d207b7461726765743a206576656e742e7461726765747d0a202020207d0a2020202072657475726e206576656e740a20207d0a0a0a2020636f6e737420676f546573745265706f7274203d207b0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e206f6e65206f662074686520746573742067726f75702064697620656c656d656e74732e0a20202020202a2040706172616d207b48544d4c456c656d656e747d207461726765742054686520656c656d656e74206173736f63696174656420776974682074686520746573742067726f75702e0a20202020202a2040706172616d207b626f6f6c65616e7d2073686966744b657920496620707265737365642c20616c6c206f6620746573742064657461696c206173736f63696174656420746f2074686520746573742067726f75702069732073686f776e2e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2040706172616d207b53656c65637465644974656d737d2073656c65637465644974656d730a20202020202a2040706172616d207b66756e6374696f6e287461726765743a20456c656d656e742c20646174613a2054657374526573756c7473297d207465737447726f75704c69737448616e646c65720a20202020202a2f0a2020202074657374526573756c7473436c69636b48616e646c65723a2066756e6374696f6e20287461726765742c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073686966744b65792c0a202020202020202020202020202020202020202020202020202020202020202020202020202020646174612c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a2020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c69737448616e646c657229207b0a0a202020202020696620287461726765742e636c6173734c6973742e636f6e7461696e73282774657374526573756c7447726f75702729203d3d3d2066616c736529207b0a202020202020202072657475726e0a2020202020207d0a2020202020206966202873656c65637465644974656d732e74657374526573756c747320213d206e756c6c29207b0a20202020202020206c65742074657374526573756c7473456c656d656e74203d202f2a2a4074797065207b48544d4c456c656d656e747d2a2f2073656c65637465644974656d732e74657374526573756c74730a202020202020202074657374526573756c7473456c656d656e742e636c6173734c6973742e72656d6f7665282273656c656374656422290a202020202020202074657374526573756c7473456c656d656e742e7374796c652e6261636b67726f756e64436f6c6f72203d2073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f720a2020202020207d0a202020202020636f6e7374207465737447726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f207461726765742e69640a20202020202069662028287461726765742e6964203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d5b2754657374526573756c7473275d203d3d3d20756e646566696e65642929207b0a202020202020202072657475726e0a2020202020207d0a202020202020636f6e73742074657374526573756c7473203d202f2a2a4074797065207b54657374526573756c74737d2a2f20646174615b7465737447726f757049645d5b2754657374526573756c7473275d0a2020202020206c6574207465737447726f75704c697374203d202f2a2a4074797065207b737472696e677d2a2f2027270a20202020202073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f72203d20676574436f6d70757465645374796c6528746172676574292e67657450726f706572747956616c756528276261636b67726f756e642d636f6c6f7227290a20202020202073656c65637465644974656d732e74657374526573756c7473203d207461726765740a2020202020207461726765742e636c6173734c6973742e616464282273656c656374656422290a202020202020666f7220286c65742069203d20303b2069203c2074657374526573756c74732e6c656e6774683b20692b2b29207b0a2020202020202020636f6e73742074657374526573756c74203d202f2a2a4074797065207b5465737447726f7570446174617d2a2f2074657374526573756c74735b695d0a2020202020202020636f6e73742074657374506173736564203d202f2a2a4074797065207b626f6f6c65616e7d2a2f2074657374526573756c742e5061737365640a2020202020202020636f6e73742074657374536b6970706564203d202f2a2a4074797065207b626f6f6c65616e7d2a2f2074657374526573756c742e536b69707065640a2020202020202020636f6e73742074657374506173736564537461747573203d202f2a2a4074797065207b737472696e677d2a2f20287465737450617373656429203f202727203a202874657374536b6970706564203f2027736b697070656427203a20276661696c656427290a2020202020202020636f6e737420746573744964203d202f2a2a4074797065207b737472696e677d2a2f207461726765742e617474726962757465735b276964275d2e76616c75650a20202020202020207465737447726f75704c697374202b3d20603c64697620636c6173733d227465737447726f7570526f7720247b746573745061737365645374617475737d2220646174612d67726f757069643d22247b7465737449647d2220646174612d696e6465783d22247b697d223e0a20202020202020203c7370616e20636c6173733d227465737453746174757320247b746573745061737365645374617475737d223e247b287465737450617373656429203f202726636865636b27203a202874657374536b6970706564203f2027266461736827203a20272663726f737327297d3b3c2f7370616e3e0a20202020202020203c7370616e20636c6173733d22746573745469746c65223e247b74657374526573756c742e546573744e616d657d3c2f7370616e3e0a20202020202020203c7370616e20636c6173733d22746573744475726174696f6e223e3c7370616e3e247b74657374526573756c742e456c617073656454696d657d73203c2f7370616e3ee28fb13c2f7370616e3e0a2020202020203c2f6469763e600a2020202020207d0a202020202020636f6e7374207465737447726f75704c697374456c656d203d20656c656d656e74732e7465737447726f75704c697374456c656d0a2020202020207465737447726f75704c697374456c656d2e696e6e657248544d4c203d2027270a2020202020207465737447726f75704c697374456c656d2e696e6e657248544d4c203d207465737447726f75704c6973740a0a2020202020206966202873686966744b657929207b0a20202020202020207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e7465737447726f7570526f7727290a202020202020202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e207465737447726f75704c69737448616e646c657228656c656d2c206461746129290a2020202020207d20656c7365206966202874657374526573756c74732e6c656e677468203d3d3d203129207b0a20202020202020207465737447726f75704c69737448616e646c6572287465737447726f75704c697374456c656d2e717565727953656c6563746f7228272e7465737447726f7570526f7727292c2064617461290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a0a20202020202a2040706172616d207b456c656d656e747d207461726765740a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a202020207465737447726f75704c69737448616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e73742061747472696273203d207461726765745b2761747472696275746573275d0a20202020202069662028617474726962732e6861734f776e50726f70657274792827646174612d67726f75706964272929207b0a2020202020202020636f6e73742067726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d67726f75706964275d2e76616c75650a2020202020202020636f6e73742074657374496e646578203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d696e646578275d2e76616c75650a2020202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f20646174615b67726f757049645d5b2754657374526573756c7473275d5b74657374496e6465785d0a2020202020202020636f6e737420746573744f7574707574446976203d202f2a2a4074797065207b48544d4c446976456c656d656e747d2a2f207461726765742e717565727953656c6563746f7228276469762e746573744f757470757427290a0a202020202020202069662028746573744f7574707574446976203d3d206e756c6c29207b0a20202020202020202020636f6e737420746573744f7574707574446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202020202020746573744f75747075744469762e636c6173734c6973742e6164642827746573744f757470757427290a20202020202020202020636f6e737420636f6e736f6c65507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a20202020202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827636f6e736f6c6527290a20202020202020202020636f6e7374207465737444657461696c446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737444657461696c4469762e636c6173734c6973742e61646428277465737444657461696c27290a20202020202020202020636f6e7374207061636b6167654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207061636b6167654e616d654469762e636c6173734c6973742e61646428277061636b61676527290a202020202020202020207061636b6167654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e5061636b6167653a3c2f7374726f6e673e20247b746573745374617475732e5061636b6167657d600a20202020202020202020636f6e7374207465737446696c654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737446696c654e616d654469762e636c6173734c6973742e616464282766696c656e616d6527290a2020202020202020202069662028746573745374617475732e5465737446696c654e616d652e7472696d2829203d3d3d20222229207b0a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e46696c656e616d653a3c2f7374726f6e673e206e2f6120266e6273703b266e6273703b600a202020202020202020207d20656c7365207b0a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e46696c656e616d653a3c2f7374726f6e673e20247b746573745374617475732e5465737446696c654e616d657d20266e6273703b266e6273703b600a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c202b3d20603c7374726f6e673e4c696e653a3c2f7374726f6e673e20247b746573745374617475732e5465737446756e6374696f6e44657461696c2e4c696e657d20600a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c202b3d20603c7374726f6e673e436f6c3a3c2f7374726f6e673e20247b746573745374617475732e5465737446756e6374696f6e44657461696c2e436f6c7d600a202020202020202020207d0a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207061636b6167654e616d65446976290a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737446696c654e616d65446976290a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20636f6e736f6c65507265290a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737444657461696c446976290a202020202020202020207461726765742e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20746573744f7574707574446976290a0a2020202020202020202069662028746573745374617475732e50617373656429207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020202020202020207d20656c73652069662028746573745374617475732e536b697070656429207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020202020202020207d20656c7365207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e61646428276661696c656427290a202020202020202020207d0a20202020202020202020636f6e736f6c655072652e74657874436f6e74656e74203d20746573745374617475732e4f75747075742e6a6f696e282727290a20202020202020207d20656c7365207b0a20202020202020202020746573744f75747075744469762e72656d6f766528290a20202020202020207d0a2020202020207d0a202020207d0a20207d0a0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a20202f2f7c20202020736574757020444f4d206576656e7473202020207c0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a2020656c656d656e74732e74657374526573756c7473456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e0a202020202020202020202020676f546573745265706f72742e74657374526573756c7473436c69636b48616e646c6572282f2a2a4074797065207b48544d4c456c656d656e747d2a2f206164644576656e7444617461286576656e74292e646174612e7461726765742c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020206576656e742e73686966744b65792c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020656c656d656e74732e646174612c0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c657229290a0a2020656c656d656e74732e7465737447726f75704c697374456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e0a202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c6572282f2a2a4074797065207b456c656d656e747d2a2f206576656e742e7461726765742c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020656c656d656e74732e6461746129290a0a202072657475726e20676f546573745265706f72740a7d0a`
+var testReportJsCode = `2f2a2a0a202a20407479706564656620546573745374617475730a202a204070726f7065727479207b737472696e677d20546573744e616d650a202a204070726f7065727479207b737472696e677d205061636b6167650a202a204070726f7065727479207b737472696e677d20536f757263650a202a204070726f7065727479207b6e756d6265727d20456c617073656454696d650a202a204070726f7065727479207b41727261792e3c737472696e673e7d204f75747075740a202a204070726f7065727479207b626f6f6c65616e7d205061737365640a202a204070726f7065727479207b626f6f6c65616e7d20536b69707065640a202a204070726f7065727479207b626f6f6c65616e7d20466c616b790a202a204070726f7065727479207b626f6f6c65616e7d2054696d65644f75740a202a204070726f7065727479207b626f6f6c65616e7d20496e636f6d706c6574650a202a204070726f7065727479207b41727261792e3c54657374417474656d70743e7d20417474656d7074730a202a204070726f7065727479207b626f6f6c65616e7d2046757a7a0a202a204070726f7065727479207b46757a7a4661696c7572657c6e756c6c7d2046757a7a4661696c7572650a202a204070726f7065727479207b50616e696344657461696c7c6e756c6c7d2050616e69630a202a204070726f7065727479207b41727261792e3c737472696e673e7c6e756c6c7d2052616365730a202a204070726f7065727479207b737472696e677d204661696c75726553756d6d6172790a202a204070726f7065727479207b41727261792e3c546573746966794661696c7572653e7c6e756c6c7d20546573746966794661696c757265730a202a204070726f7065727479207b41727261792e3c546573745374617475733e7d2053756274657374730a202a204070726f7065727479207b6e756d6265727d204e756d4f6653756274657374735061737365640a202a204070726f7065727479207b6e756d6265727d204e756d4f6653756274657374734661696c65640a202a204070726f7065727479207b6e756d6265727d204e756d4f665375627465737473536b69707065640a202a204070726f7065727479207b6e756d6265727d204e756d4f665375627465737473496e636f6d706c6574650a202a2f0a636c6173732054657374537461747573207b7d0a0a2f2a2a0a202a2040747970656465662054657374417474656d70740a202a204070726f7065727479207b6e756d6265727d20456c617073656454696d650a202a204070726f7065727479207b41727261792e3c737472696e673e7d204f75747075740a202a204070726f7065727479207b626f6f6c65616e7d205061737365640a202a204070726f7065727479207b626f6f6c65616e7d20536b69707065640a202a204070726f7065727479207b626f6f6c65616e7d20496e636f6d706c6574650a202a2f0a636c6173732054657374417474656d7074207b7d0a0a2f2a2a0a202a2040747970656465662046757a7a4661696c7572650a202a204070726f7065727479207b737472696e677d20496e70757446696c650a202a204070726f7065727479207b737472696e677d20496e7075740a202a204070726f7065727479207b737472696e677d20526570726f436f6d6d616e640a202a2f0a636c6173732046757a7a4661696c757265207b7d0a0a2f2a2a0a202a20407479706564656620537461636b4672616d650a202a204070726f7065727479207b737472696e677d2046756e6374696f6e0a202a204070726f7065727479207b737472696e677d2046696c650a202a204070726f7065727479207b6e756d6265727d204c696e650a202a204070726f7065727479207b626f6f6c65616e7d204372656174656442790a202a204070726f7065727479207b626f6f6c65616e7d20496e4d6f64756c650a202a204070726f7065727479207b626f6f6c65616e7d2052756e74696d650a202a2f0a636c61737320537461636b4672616d65207b7d0a0a2f2a2a0a202a20407479706564656620476f726f7574696e65537461636b0a202a204070726f7065727479207b6e756d6265727d2049440a202a204070726f7065727479207b737472696e677d2053746174650a202a204070726f7065727479207b41727261792e3c537461636b4672616d653e7d204672616d65730a202a2f0a636c61737320476f726f7574696e65537461636b207b7d0a0a2f2a2a0a202a2040747970656465662050616e696344657461696c0a202a204070726f7065727479207b737472696e677d2056616c75650a202a204070726f7065727479207b41727261792e3c476f726f7574696e65537461636b3e7d20476f726f7574696e65730a202a2f0a636c6173732050616e696344657461696c207b7d0a0a2f2a2a0a202a20407479706564656620526163654163636573730a202a204070726f7065727479207b737472696e677d204465736372697074696f6e0a202a204070726f7065727479207b41727261792e3c537461636b4672616d653e7d204672616d65730a202a2f0a636c6173732052616365416363657373207b7d0a0a2f2a2a0a202a2040747970656465662052616365546573740a202a204070726f7065727479207b737472696e677d20546573744e616d650a202a204070726f7065727479207b737472696e677d205061636b6167650a202a2f0a636c617373205261636554657374207b7d0a0a2f2a2a0a202a2040747970656465662044617461526163650a202a204070726f7065727479207b737472696e677d2049440a202a204070726f7065727479207b41727261792e3c526163654163636573733e7d2041636365737365730a202a204070726f7065727479207b41727261792e3c476f726f7574696e65537461636b3e7d20476f726f7574696e65730a202a204070726f7065727479207b737472696e677d204c6f636174696f6e0a202a204070726f7065727479207b41727261792e3c52616365546573743e7d2054657374730a202a2f0a636c617373204461746152616365207b7d0a0a2f2a2a0a202a2040747970656465662054726163654c6f636174696f6e0a202a204070726f7065727479207b737472696e677d2046696c650a202a204070726f7065727479207b6e756d6265727d204c696e650a202a2f0a636c6173732054726163654c6f636174696f6e207b7d0a0a2f2a2a0a202a20407479706564656620446966664c696e650a202a204070726f7065727479207b737472696e677d204b696e640a202a204070726f7065727479207b737472696e677d20546578740a202a2f0a636c61737320446966664c696e65207b7d0a0a2f2a2a0a202a20407479706564656620546573746966794661696c7572650a202a204070726f7065727479207b737472696e677d204c6f636174696f6e0a202a204070726f7065727479207b41727261792e3c54726163654c6f636174696f6e3e7c6e756c6c7d204572726f7254726163650a202a204070726f7065727479207b737472696e677d204572726f720a202a204070726f7065727479207b737472696e677d204572726f7244657461696c0a202a204070726f7065727479207b737472696e677d2045787065637465640a202a204070726f7065727479207b737472696e677d2041637475616c0a202a204070726f7065727479207b41727261792e3c446966664c696e653e7c6e756c6c7d20446966660a202a204070726f7065727479207b737472696e677d204d657373616765730a202a2f0a636c61737320546573746966794661696c757265207b7d0a0a2f2a2a0a202a204074797065646566205465737447726f7570446174610a202a204074797065207b6f626a6563747d0a202a204070726f7065727479207b737472696e677d204661696c757265496e64696361746f720a202a204070726f7065727479207b737472696e677d20536b6970706564496e64696361746f720a202a204070726f7065727479207b737472696e677d20496e636f6d706c657465496e64696361746f720a202a204070726f7065727479207b41727261792e3c546573745374617475733e7d0a202a2f0a636c617373205465737447726f757044617461207b7d0a0a2f2a2a0a202a2040747970656465662054657374526573756c74730a202a204074797065207b41727261792e3c5465737447726f7570446174613e7d0a202a2f0a636c6173732054657374526573756c747320657874656e6473204172726179207b7d0a0a2f2a2a0a202a2040747970656465662053656c65637465644974656d730a202a204070726f7065727479207b48544d4c456c656d656e747c4576656e745461726765747d2074657374526573756c74730a202a204070726f7065727479207b537472696e677d2073656c65637465645465737447726f7570436f6c6f720a202a2f0a636c6173732053656c65637465644974656d73207b7d0a0a2f2a2a0a202a20407479706564656620476f546573745265706f7274456c656d656e74730a202a204070726f7065727479207b54657374526573756c74737d20646174610a202a204070726f7065727479207b48544d4c456c656d656e747d2074657374526573756c7473456c656d0a202a204070726f7065727479207b48544d4c456c656d656e747d207465737447726f75704c697374456c656d0a202a204070726f7065727479207b48544d4c456c656d656e747c6e756c6c7d2062656e63686d61726b5461626c65456c656d0a202a204070726f7065727479207b48544d4c456c656d656e747c6e756c6c7d207061636b6167654c697374456c656d0a202a204070726f7065727479207b41727261792e3c44617461526163653e7c6e756c6c7d2072616365730a202a204070726f7065727479207b48544d4c456c656d656e747c6e756c6c7d20726163654c697374456c656d0a202a2f0a636c61737320476f546573745265706f7274456c656d656e7473207b7d0a0a0a2f2a2a0a202a204d61696e20656e74727920706f696e7420666f7220476f546573745265706f72742e0a202a2040706172616d207b476f546573745265706f7274456c656d656e74737d20656c656d656e74730a202a204072657475726e73207b7b74657374526573756c7473436c69636b48616e646c65723a2074657374526573756c7473436c69636b48616e646c65722c207465737447726f75704c69737448616e646c65723a207465737447726f75704c69737448616e646c65722c2073756274657374546f67676c6548616e646c65723a2073756274657374546f67676c6548616e646c65722c20617474656d707454616248616e646c65723a20617474656d707454616248616e646c65722c207261774f7574707574546f67676c6548616e646c65723a207261774f7574707574546f67676c6548616e646c65722c2062656e63686d61726b5461626c6548616e646c65723a2062656e63686d61726b5461626c6548616e646c65722c20636f7665726167654c696e6b48616e646c65723a20636f7665726167654c696e6b48616e646c65727d7d0a202a2040636f6e7374727563746f720a202a2f0a77696e646f772e476f546573745265706f7274203d2066756e6374696f6e2028656c656d656e747329207b0a2020636f6e7374202f2a2a4074797065207b53656c65637465644974656d737d2a2f2073656c65637465644974656d73203d207b0a2020202074657374526573756c74733a206e756c6c2c0a2020202073656c65637465645465737447726f7570436f6c6f723a206e756c6c0a20207d0a0a202066756e6374696f6e206164644576656e7444617461286576656e7429207b0a20202020696620286576656e742e64617461203d3d206e756c6c29207b0a2020202020206576656e742e64617461203d207b7461726765743a206576656e742e7461726765747d0a202020207d0a2020202072657475726e206576656e740a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865207465737420737461747573207265666572656e636564206279206120746573742067726f757020726f772e20526f7773206f6620737562746573747320636172727920612022646174612d737562696e64657822206174747269627574650a2020202a20636f6e7461696e696e672074686520646f742d7365706172617465642070617468206f6620696e646578657320696e746f20746865205375627465737473206f662074686520746f70206c6576656c20746573742e0a2020202a2040706172616d207b456c656d656e747d207461726765740a2020202a2040706172616d207b54657374526573756c74737d20646174610a2020202a204072657475726e73207b546573745374617475737d0a2020202a2f0a202066756e6374696f6e2067657454657374537461747573287461726765742c206461746129207b0a20202020636f6e73742061747472696273203d207461726765745b2761747472696275746573275d0a20202020636f6e73742067726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d67726f75706964275d2e76616c75650a20202020636f6e73742074657374496e646578203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d696e646578275d2e76616c75650a202020206c65742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f20646174615b67726f757049645d5b2754657374526573756c7473275d5b74657374496e6465785d0a2020202069662028617474726962732e6861734f776e50726f70657274792827646174612d737562696e646578272929207b0a202020202020617474726962735b27646174612d737562696e646578275d2e76616c75650a2020202020202020202020202020202020202020202020202020202020202e73706c697428272e27290a2020202020202020202020202020202020202020202020202020202020202e666f72456163682828737562496e64657829203d3e2074657374537461747573203d20746573745374617475732e53756274657374735b737562496e6465785d290a202020207d0a2020202072657475726e20746573745374617475730a20207d0a0a20202f2a2a0a2020202a20436f6c6f727320746865206f757470757420636f6e736f6c65207573696e672074686520737461747573206f6620612074657374206f72206f66206f6e65206f662069747320617474656d7074732e0a2020202a2040706172616d207b48544d4c456c656d656e747d20636f6e736f6c655072650a2020202a2040706172616d207b546573745374617475737c54657374417474656d70747d207374617475730a2020202a2f0a202066756e6374696f6e20736574436f6e736f6c6553746174757328636f6e736f6c655072652c2073746174757329207b0a20202020696620287374617475732e50617373656429207b0a202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020207d20656c736520696620287374617475732e536b697070656429207b0a202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827736b697070656427290a202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020207d20656c7365207b0a202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020636f6e736f6c655072652e636c6173734c6973742e61646428276661696c656427290a202020207d0a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f66207468652074616273207573656420746f20737769746368206265747765656e20746865206f7574707574206f662074686520617474656d707473206f662061207465737420746861742072616e206d6f7265207468616e0a2020202a206f6e63652e20546865206669727374207461622073686f777320746865206f7574707574206f6620616c6c20617474656d7074732e0a2020202a2040706172616d207b546573745374617475737d20746573745374617475730a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20617474656d70745461627348544d4c287465737453746174757329207b0a202020206c65742074616273203d202f2a2a4074797065207b737472696e677d2a2f20603c7370616e20636c6173733d22617474656d70745461622073656c65637465642220646174612d617474656d70743d22223e416c6c20617474656d7074733c2f7370616e3e600a20202020746573745374617475732e417474656d7074732e666f72456163682828617474656d70742c206929203d3e207b0a2020202020206c657420617474656d7074537461747573203d202f2a2a4074797065207b737472696e677d2a2f2028617474656d70742e50617373656429203f202770617373656427203a2028617474656d70742e536b6970706564203f2027736b697070656427203a20276661696c656427290a2020202020206c657420617474656d707449636f6e203d202f2a2a4074797065207b737472696e677d2a2f2028617474656d70742e50617373656429203f202726636865636b3b27203a2028617474656d70742e536b6970706564203f202726646173683b27203a20272663726f73733b27290a20202020202069662028617474656d70742e496e636f6d706c65746529207b0a2020202020202020617474656d7074537461747573203d2027696e636f6d706c657465270a2020202020202020617474656d707449636f6e203d20273f270a2020202020207d0a20202020202074616273202b3d20603c7370616e20636c6173733d22617474656d707454616220247b617474656d70745374617475737d2220646174612d617474656d70743d22247b697d223e23247b69202b20317d20247b617474656d707449636f6e7d20247b617474656d70742e456c617073656454696d657d733c2f7370616e3e600a202020207d290a2020202072657475726e20746162730a20207d0a0a20202f2a2a0a2020202a2045736361706573207468652063686172616374657273206f6620612074657874207468617420686176652061207370656369616c206d65616e696e6720696e2048544d4c2e0a2020202a2040706172616d207b737472696e677d20746578740a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e2065736361706548544d4c287465787429207b0a2020202072657475726e20746578742e7265706c616365282f262f672c202726616d703b27290a2020202020202020202020202020202e7265706c616365282f3c2f672c2027266c743b27290a2020202020202020202020202020202e7265706c616365282f3e2f672c20272667743b27290a2020202020202020202020202020202e7265706c616365282f222f672c20272671756f743b27290a20207d0a0a20202f2a2a0a2020202a2052657475726e73207468652065736361706564206d61726b7570206f662061207465787420696e207768696368207468652070617274207468617420646966666572732066726f6d20616e6f746865722074657874206973206d61726b65642c20692e652e2074686520746578740a2020202a206265747765656e207468652070726566697820616e642074686520737566666978207468652074776f207465787473206861766520696e20636f6d6d6f6e2e0a2020202a2040706172616d207b737472696e677d20746578740a2020202a2040706172616d207b737472696e677d206f746865720a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e206d61726b446966666572656e636528746578742c206f7468657229207b0a20202020636f6e7374206d61784c656e677468203d202f2a2a4074797065207b6e756d6265727d2a2f204d6174682e6d696e28746578742e6c656e6774682c206f746865722e6c656e677468290a202020206c657420707265666978203d202f2a2a4074797065207b6e756d6265727d2a2f20300a202020207768696c652028707265666978203c206d61784c656e67746820262620746578745b7072656669785d203d3d3d206f746865725b7072656669785d29207b0a2020202020207072656669782b2b0a202020207d0a202020206c657420737566666978203d202f2a2a4074797065207b6e756d6265727d2a2f20300a202020207768696c652028737566666978203c206d61784c656e677468202d2070726566697820262620746578745b746578742e6c656e677468202d2031202d207375666669785d203d3d3d206f746865725b6f746865722e6c656e677468202d2031202d207375666669785d29207b0a2020202020207375666669782b2b0a202020207d0a2020202069662028707265666978202b20737566666978203d3d3d20746578742e6c656e67746829207b0a20202020202072657475726e2065736361706548544d4c2874657874290a202020207d0a2020202072657475726e2065736361706548544d4c28746578742e737562737472696e6728302c207072656669782929202b0a2020202020202020202020603c6d61726b3e247b65736361706548544d4c28746578742e737562737472696e67287072656669782c20746578742e6c656e677468202d2073756666697829297d3c2f6d61726b3e60202b0a202020202020202020202065736361706548544d4c28746578742e737562737472696e6728746578742e6c656e677468202d2073756666697829290a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206e756d626572206f66207768697465737061636520636861726163746572732061206c696e65206f66206f757470757420697320696e64656e7465642062792e0a2020202a2040706172616d207b737472696e677d206c696e650a2020202a204072657475726e73207b6e756d6265727d0a2020202a2f0a202066756e6374696f6e20696e64656e746174696f6e286c696e6529207b0a2020202072657475726e206c696e652e6c656e677468202d206c696e652e7472696d537461727428292e6c656e6774680a20207d0a0a20202f2f20652e672e2022666f6f5f746573742e676f3a34323a206d69736d6174636820282d77616e74202b676f74293a220a2020636f6e737420636d7044696666486561646572526567457870203d202f5b285c735d2d5c772b205c2b5c772b5c293f3a3f5c732a242f0a20202f2f20652e672e202220202d205c744e616d653a2022666f6f222c2220696e20746865206f7574707574206f6620636d702e446966662c2077686963682072616e646f6d6c7920757365732061206e6f6e2d627265616b696e6720737061636520616674657220746865206d61726b65720a2020636f6e737420636d70446966664c696e65526567457870203d202f5e5c732a285b2d2b5d295b5c735c75303061305d2f0a20202f2f20652e672e2022666f6f5f746573742e676f3a34323a20676f7420312c2077616e74203222206f722022666f6f5f746573742e676f3a34323a2077616e74202261222062757420676f7420226222220a2020636f6e737420676f7457616e74526567457870203d202f5e282e2a3f5c6228676f747c77616e74295c623a3f5c732b29282e2b3f29282c3f5c732b283f3a6275745c732b293f28676f747c77616e74295c623a3f5c732b29282e2b3f29285c732a29242f0a20202f2f20652e672e20222020202020202020676f743a2020312220666f6c6c6f7765642062792022202020202020202077616e743a2032220a2020636f6e737420676f7457616e744c696e65526567457870203d202f5e285c732a28676f747c77616e74293a5c732a29282e2a3f29285c732a29242f0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f6620746865206c696e6573206f66206120636d702e4469666620626c6f636b2e205468652072656d6f76656420616e6420746865206164646564206c696e65732061726520636f6c6f7265642c2074686520646966666572656e6365730a2020202a206f6620612072656d6f766564206c696e6520616e6420746865206164646564206c696e65207265706c6163696e6720697420617265206d61726b65642e0a2020202a2040706172616d207b41727261792e3c737472696e673e7d206c696e65730a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20636d704469666648544d4c286c696e657329207b0a20202020636f6e7374206d61726b657273203d202f2a2a4074797065207b41727261792e3c737472696e673e7d2a2f206c696e65732e6d617028286c696e6529203d3e207b0a202020202020636f6e7374206d61746368203d206c696e652e6d6174636828636d70446966664c696e65526567457870290a20202020202072657475726e20286d61746368203d3d206e756c6c29203f202727203a206d617463685b315d0a202020207d290a202020202f2f20612072756e206f662072656d6f766564206c696e657320666f6c6c6f77656420627920612072756e206f66206164646564206c696e6573207265706c61636573207468652072656d6f766564206c696e6573207769746820746865206164646564206c696e65732c207468650a202020202f2f206c696e6573206174207468652073616d6520706f736974696f6e206f66207468652074776f2072756e7320617265207061697265640a20202020636f6e7374207061697273203d202f2a2a4074797065207b41727261792e3c6e756d6265723e7d2a2f206c696e65732e6d6170282829203d3e202d31290a20202020666f7220286c65742069203d20303b2069203c206c696e65732e6c656e6774683b29207b0a202020202020696620286d61726b6572735b695d20213d3d20272d2729207b0a2020202020202020692b2b0a2020202020202020636f6e74696e75650a2020202020207d0a2020202020206c65742072656d6f766564456e64203d20690a2020202020207768696c65202872656d6f766564456e64203c206c696e65732e6c656e677468202626206d61726b6572735b72656d6f766564456e645d203d3d3d20272d2729207b0a202020202020202072656d6f766564456e642b2b0a2020202020207d0a2020202020206c6574206164646564456e64203d2072656d6f766564456e640a2020202020207768696c6520286164646564456e64203c206c696e65732e6c656e677468202626206d61726b6572735b6164646564456e645d203d3d3d20272b2729207b0a20202020202020206164646564456e642b2b0a2020202020207d0a202020202020666f7220286c6574206a203d20303b206a203c204d6174682e6d696e2872656d6f766564456e64202d20692c206164646564456e64202d2072656d6f766564456e64293b206a2b2b29207b0a202020202020202070616972735b69202b206a5d203d2072656d6f766564456e64202b206a0a202020202020202070616972735b72656d6f766564456e64202b206a5d203d2069202b206a0a2020202020207d0a20202020202069203d206164646564456e640a202020207d0a202020202f2f207468652074657874206f662061206c696e6520666f6c6c6f77696e6720697473206d61726b65720a20202020636f6e7374206d61726b656454657874203d20286929203d3e206c696e65735b695d2e737562737472696e67286c696e65735b695d2e696e6465784f66286d61726b6572735b695d29202b2031290a2020202072657475726e206c696e65732e6d617028286c696e652c206929203d3e207b0a202020202020696620286d61726b6572735b695d203d3d3d20272729207b0a202020202020202072657475726e2065736361706548544d4c286c696e65290a2020202020207d0a202020202020636f6e7374206b696e64203d202f2a2a4074797065207b737472696e677d2a2f20286d61726b6572735b695d203d3d3d20272d2729203f202772656d6f76656427203a20276164646564270a202020202020636f6e7374206d61726b6572203d202f2a2a4074797065207b737472696e677d2a2f206c696e652e737562737472696e6728302c206c696e652e696e6465784f66286d61726b6572735b695d29202b2031290a202020202020636f6e7374207465787448544d4c203d202f2a2a4074797065207b737472696e677d2a2f202870616972735b695d203c203029203f2065736361706548544d4c286d61726b65645465787428692929203a206d61726b446966666572656e6365286d61726b6564546578742869292c206d61726b6564546578742870616972735b695d29290a20202020202072657475726e20603c7370616e20636c6173733d22646966664c696e6520247b6b696e647d223e247b65736361706548544d4c286d61726b6572297d247b7465787448544d4c7d3c2f7370616e3e600a202020207d292e6a6f696e28275c6e27290a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f66206120676f74206f7220612077616e742076616c75652c207468652070617274206f66207468652076616c7565207468617420646966666572732066726f6d20746865206f746865722076616c7565206973206d61726b65642e0a2020202a2040706172616d207b737472696e677d206b6579776f7264204569746865722022676f7422206f72202277616e74222e0a2020202a2040706172616d207b737472696e677d2076616c75650a2020202a2040706172616d207b737472696e677d206f746865720a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20676f7457616e7456616c756548544d4c286b6579776f72642c2076616c75652c206f7468657229207b0a2020202072657475726e20603c7370616e20636c6173733d226469666656616c756520247b6b6579776f72647d223e247b6d61726b446966666572656e63652876616c75652c206f74686572297d3c2f7370616e3e600a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f6620746865206f7574707574206f662061207465737420696e20776869636820746865206469666673206f6620746865206661696c757265206d657373616765732061726520686967686c6967687465643a207468650a2020202a20626c6f636b73207072696e746564207769746820676f2d636d70277320636d702e446966662c20696e74726f64756365642062792061206c696e6520656e64696e67207769746820652e672e2022282d77616e74202b676f74293a222c20616e642074686520676f7420616e640a2020202a2077616e742076616c756573206f66206d65737361676573206c696b652022676f7420312c2077616e74203222206f72206f6620636f6e73656375746976652022676f743a2220616e64202277616e743a22206c696e65732e2052657475726e73206e756c6c206966207468650a2020202a206f757470757420636f6e7461696e73206e6f2064696666732c20736f20746861742069742069732073686f776e2061732069732e0a2020202a2040706172616d207b41727261792e3c737472696e673e7d206f75747075740a2020202a204072657475726e73207b737472696e677c6e756c6c7d0a2020202a2f0a202066756e6374696f6e206f7574707574446966667348544d4c286f757470757429207b0a20202020636f6e7374206c696e6573203d202f2a2a4074797065207b41727261792e3c737472696e673e7d2a2f206f75747075742e6a6f696e282727292e73706c697428275c6e27290a20202020636f6e7374206c696e657348544d4c203d202f2a2a4074797065207b41727261792e3c737472696e673e7d2a2f205b5d0a202020206c6574206e756d4f664469666673203d202f2a2a4074797065207b6e756d6265727d2a2f20300a20202020666f7220286c65742069203d20303b2069203c206c696e65732e6c656e6774683b20692b2b29207b0a202020202020636f6e7374206c696e65203d202f2a2a4074797065207b737472696e677d2a2f206c696e65735b695d0a20202020202069662028636d70446966664865616465725265674578702e74657374286c696e652929207b0a20202020202020202f2f20746865206c696e6573206f662074686520646966662061726520696e64656e74656420646565706572207468616e20746865206d65737361676520696e74726f647563696e672069740a20202020202020206c657420656e64203d2069202b20310a20202020202020207768696c652028656e64203c206c696e65732e6c656e677468202626206c696e65735b656e645d2e7472696d282920213d3d20272720262620696e64656e746174696f6e286c696e65735b656e645d29203e20696e64656e746174696f6e286c696e652929207b0a20202020202020202020656e642b2b0a20202020202020207d0a2020202020202020636f6e737420646966664c696e6573203d202f2a2a4074797065207b41727261792e3c737472696e673e7d2a2f206c696e65732e736c6963652869202b20312c20656e64290a202020202020202069662028646966664c696e65732e736f6d652828646966664c696e6529203d3e20636d70446966664c696e655265674578702e7465737428646966664c696e65292929207b0a202020202020202020206c696e657348544d4c2e707573682865736361706548544d4c286c696e65292c20636d704469666648544d4c28646966664c696e657329290a202020202020202020206e756d4f6644696666732b2b0a2020202020202020202069203d20656e64202d20310a20202020202020202020636f6e74696e75650a20202020202020207d0a2020202020207d0a202020202020636f6e7374206d61746368203d206c696e652e6d6174636828676f7457616e74526567457870290a202020202020696620286d6174636820213d206e756c6c202626206d617463685b325d20213d3d206d617463685b355d29207b0a20202020202020206c696e657348544d4c2e707573682865736361706548544d4c286d617463685b315d29202b20676f7457616e7456616c756548544d4c286d617463685b325d2c206d617463685b335d2c206d617463685b365d29202b0a202020202020202020202020202020202020202020202065736361706548544d4c286d617463685b345d29202b20676f7457616e7456616c756548544d4c286d617463685b355d2c206d617463685b365d2c206d617463685b335d29202b2065736361706548544d4c286d617463685b375d29290a20202020202020206e756d4f6644696666732b2b0a2020202020202020636f6e74696e75650a2020202020207d0a202020202020636f6e7374206c696e654d61746368203d206c696e652e6d6174636828676f7457616e744c696e65526567457870290a202020202020636f6e7374206e6578744d61746368203d20286c696e654d61746368203d3d206e756c6c207c7c2069202b2031203e3d206c696e65732e6c656e67746829203f206e756c6c203a206c696e65735b69202b20315d2e6d6174636828676f7457616e744c696e65526567457870290a202020202020696620286e6578744d6174636820213d206e756c6c202626206c696e654d617463685b325d20213d3d206e6578744d617463685b325d29207b0a20202020202020206c696e657348544d4c2e707573682865736361706548544d4c286c696e654d617463685b315d29202b20676f7457616e7456616c756548544d4c286c696e654d617463685b325d2c206c696e654d617463685b335d2c206e6578744d617463685b335d29202b2065736361706548544d4c286c696e654d617463685b345d292c0a202020202020202020202020202020202020202020202065736361706548544d4c286e6578744d617463685b315d29202b20676f7457616e7456616c756548544d4c286e6578744d617463685b325d2c206e6578744d617463685b335d2c206c696e654d617463685b335d29202b2065736361706548544d4c286e6578744d617463685b345d29290a20202020202020206e756d4f6644696666732b2b0a2020202020202020692b2b0a2020202020202020636f6e74696e75650a2020202020207d0a2020202020206c696e657348544d4c2e707573682865736361706548544d4c286c696e6529290a202020207d0a2020202072657475726e20286e756d4f664469666673203e203029203f206c696e657348544d4c2e6a6f696e28275c6e2729203a206e756c6c0a20207d0a0a20202f2a2a0a2020202a2053686f777320746865206f7574707574206f66206120746573742c206f72206f66206f6e65206f662069747320617474656d7074732c20696e20746865206f757470757420636f6e736f6c652e20546865206469666673206f6620746865206661696c757265206d657373616765730a2020202a2061726520686967686c69676874656420756e6c6573732074686520726177206f7574707574207761732073656c656374656420776974682074686520746f67676c65206f662074686520636f6e736f6c652e0a2020202a2040706172616d207b48544d4c456c656d656e747d20636f6e736f6c655072650a2020202a2040706172616d207b41727261792e3c737472696e673e7d206f75747075740a2020202a2f0a202066756e6374696f6e20736574436f6e736f6c654f757470757428636f6e736f6c655072652c206f757470757429207b0a20202020636f6e737420646966667348544d4c203d202f2a2a4074797065207b737472696e677c6e756c6c7d2a2f20636f6e736f6c655072652e636c6173734c6973742e636f6e7461696e7328277261772729203f206e756c6c203a206f7574707574446966667348544d4c286f7574707574290a2020202069662028646966667348544d4c203d3d206e756c6c29207b0a202020202020636f6e736f6c655072652e74657874436f6e74656e74203d206f75747075742e6a6f696e282727290a202020207d20656c7365207b0a202020202020636f6e736f6c655072652e696e6e657248544d4c203d20646966667348544d4c0a202020207d0a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f6620746865206672616d6573206f66206120676f726f7574696e6520737461636b2e204672616d6573206f6620746865206d6f64756c6520756e64657220746573742061726520686967686c69676874656420616e640a2020202a20636f6e7365637574697665206672616d6573206f66207468652072756e74696d6520616e642074657374696e67207061636b616765732061726520636f6c6c61707365642e0a2020202a2040706172616d207b41727261792e3c537461636b4672616d653e7d206672616d65730a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20737461636b4672616d657348544d4c286672616d657329207b0a202020206c6574206672616d657348544d4c203d202f2a2a4074797065207b737472696e677d2a2f2027270a202020206c65742072756e74696d654672616d6573203d202f2a2a4074797065207b737472696e677d2a2f2027270a202020206c6574206e756d4f6652756e74696d654672616d6573203d202f2a2a4074797065207b6e756d6265727d2a2f20300a20202020636f6e737420666c75736852756e74696d654672616d6573203d202829203d3e207b0a202020202020696620286e756d4f6652756e74696d654672616d6573203e203029207b0a20202020202020206672616d657348544d4c202b3d20603c64657461696c7320636c6173733d2272756e74696d654672616d6573223e3c73756d6d6172793e247b6e756d4f6652756e74696d654672616d65737d2072756e74696d652f74657374696e67206672616d652873293c2f73756d6d6172793e247b72756e74696d654672616d65737d3c2f64657461696c733e600a2020202020207d0a20202020202072756e74696d654672616d6573203d2027270a2020202020206e756d4f6652756e74696d654672616d6573203d20300a202020207d0a202020206672616d65732e666f724561636828286672616d6529203d3e207b0a202020202020636f6e7374206672616d6548544d4c203d202f2a2a4074797065207b737472696e677d2a2f20603c64697620636c6173733d22737461636b4672616d65247b6672616d652e496e4d6f64756c65203f202720696e4d6f64756c6527203a2027277d223e0a202020202020202020203c7370616e20636c6173733d2266756e6374696f6e223e247b6672616d652e437265617465644279203f2027637265617465642062792027203a2027277d247b65736361706548544d4c286672616d652e46756e6374696f6e297d3c2f7370616e3e0a202020202020202020203c7370616e20636c6173733d226c6f636174696f6e223e247b65736361706548544d4c286672616d652e46696c65297d3a247b6672616d652e4c696e657d3c2f7370616e3e0a20202020202020203c2f6469763e600a202020202020696620286672616d652e52756e74696d6529207b0a202020202020202072756e74696d654672616d6573202b3d206672616d6548544d4c0a20202020202020206e756d4f6652756e74696d654672616d65732b2b0a2020202020207d20656c7365207b0a2020202020202020666c75736852756e74696d654672616d657328290a20202020202020206672616d657348544d4c202b3d206672616d6548544d4c0a2020202020207d0a202020207d290a20202020666c75736852756e74696d654672616d657328290a2020202072657475726e206672616d657348544d4c0a20207d0a0a20202f2a2a0a2020202a2052657475726e7320616e20656c656d656e742073686f77696e67207468652076616c7565206f6620612070616e696320616e642074686520737461636b73206f662074686520676f726f7574696e65732e204f6e6c792074686520737461636b206f66207468652066697273740a2020202a20676f726f7574696e652c20746865206f6e6520746861742070616e69636b65642c20697320657870616e6465642e0a2020202a2040706172616d207b50616e696344657461696c7d2070616e696344657461696c0a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e2070616e6963456c656d656e742870616e696344657461696c29207b0a20202020636f6e73742070616e6963446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202070616e69634469762e636c6173734c6973742e616464282770616e696327290a202020206c65742070616e696348544d4c203d202f2a2a4074797065207b737472696e677d2a2f20603c64697620636c6173733d2270616e696356616c7565223e3c7374726f6e673e70616e69633a3c2f7374726f6e673e20247b65736361706548544d4c2870616e696344657461696c2e56616c7565297d3c2f6469763e600a20202020636f6e737420676f726f7574696e6573203d202f2a2a4074797065207b41727261792e3c476f726f7574696e65537461636b3e7d2a2f202870616e696344657461696c2e476f726f7574696e6573203d3d206e756c6c29203f205b5d203a2070616e696344657461696c2e476f726f7574696e65730a20202020676f726f7574696e65732e666f72456163682828676f726f7574696e652c206929203d3e207b0a20202020202070616e696348544d4c202b3d20603c64657461696c7320636c6173733d22676f726f7574696e6522247b2869203d3d3d203029203f2027206f70656e27203a2027277d3e0a202020202020202020203c73756d6d6172793e676f726f7574696e6520247b676f726f7574696e652e49447d205b247b65736361706548544d4c28676f726f7574696e652e5374617465297d5d3c2f73756d6d6172793e0a20202020202020202020247b737461636b4672616d657348544d4c2828676f726f7574696e652e4672616d6573203d3d206e756c6c29203f205b5d203a20676f726f7574696e652e4672616d6573297d0a20202020202020203c2f64657461696c733e600a202020207d290a2020202070616e69634469762e696e6e657248544d4c203d2070616e696348544d4c0a2020202072657475726e2070616e69634469760a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f662061206c6f636174696f6e206f6620616e206572726f722074726163652e204162736f6c757465207061746873206c696e6b20746f207468652066696c652c207768696368206f70656e732074686520736f757263652066696c650a2020202a207768656e20746865207265706f727420697320766965776564206f6e20746865206d616368696e6520746861742072616e207468652074657374732e0a2020202a2040706172616d207b54726163654c6f636174696f6e7d206c6f636174696f6e0a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e2074726163654c6f636174696f6e48544d4c286c6f636174696f6e29207b0a20202020636f6e73742074657874203d202f2a2a4074797065207b737472696e677d2a2f2060247b65736361706548544d4c286c6f636174696f6e2e46696c65297d3a247b6c6f636174696f6e2e4c696e657d600a2020202069662028216c6f636174696f6e2e46696c652e7374617274735769746828272f272929207b0a20202020202072657475726e20603c7370616e20636c6173733d2274726163654c6f636174696f6e223e247b746578747d3c2f7370616e3e600a202020207d0a2020202072657475726e20603c6120636c6173733d2274726163654c6f636174696f6e2220687265663d2266696c653a2f2f247b65736361706548544d4c28656e636f6465555249286c6f636174696f6e2e46696c6529297d223e247b746578747d3c2f613e600a20207d0a0a20202f2a2a0a2020202a2052657475726e7320616e20656c656d656e742073686f77696e6720746865206661696c6564207465737469667920617373657274696f6e73206f66206120746573742e2054686520657870656374656420616e64207468652061637475616c2076616c7565206172652073686f776e0a2020202a207369646520627920736964652c20666f6c6c6f7765642062792074686520636f6c6f72656420756e69666965642064696666207072696e74656420627920746573746966792e0a2020202a2040706172616d207b41727261792e3c546573746966794661696c7572653e7d206661696c757265730a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e20746573746966794661696c75726573456c656d656e74286661696c7572657329207b0a20202020636f6e7374206661696c75726573446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020206661696c757265734469762e636c6173734c6973742e6164642827746573746966794661696c7572657327290a202020206c6574206661696c7572657348544d4c203d202f2a2a4074797065207b737472696e677d2a2f2027270a202020206661696c757265732e666f724561636828286661696c75726529203d3e207b0a202020202020636f6e7374206572726f725472616365203d202f2a2a4074797065207b41727261792e3c54726163654c6f636174696f6e3e7d2a2f20286661696c7572652e4572726f725472616365203d3d206e756c6c29203f205b5d203a206661696c7572652e4572726f7254726163650a202020202020636f6e73742064696666203d202f2a2a4074797065207b41727261792e3c446966664c696e653e7d2a2f20286661696c7572652e44696666203d3d206e756c6c29203f205b5d203a206661696c7572652e446966660a2020202020206661696c7572657348544d4c202b3d20603c64697620636c6173733d22746573746966794661696c757265223e0a202020202020202020203c64697620636c6173733d22746573746966794572726f72223e3c7374726f6e673e247b65736361706548544d4c286661696c7572652e4c6f636174696f6e297d3a3c2f7374726f6e673e20247b65736361706548544d4c286661696c7572652e4572726f72297d3c2f6469763e0a20202020202020202020247b286661696c7572652e4d6573736167657320213d3d20272729203f20603c64697620636c6173733d22746573746966794d65737361676573223e3c7374726f6e673e4d657373616765733a3c2f7374726f6e673e20247b65736361706548544d4c286661696c7572652e4d65737361676573297d3c2f6469763e60203a2027277d0a20202020202020202020247b286661696c7572652e4572726f7244657461696c20213d3d20272729203f20603c70726520636c6173733d22746573746966794572726f7244657461696c223e247b65736361706548544d4c286661696c7572652e4572726f7244657461696c297d3c2f7072653e60203a2027277d0a20202020202020202020247b286661696c7572652e457870656374656420213d3d202727207c7c206661696c7572652e41637475616c20213d3d20272729203f20603c64697620636c6173733d227465737469667956616c756573223e0a2020202020202020202020203c64697620636c6173733d226578706563746564223e3c7374726f6e673e65787065637465643c2f7374726f6e673e3c7072653e247b65736361706548544d4c286661696c7572652e4578706563746564297d3c2f7072653e3c2f6469763e0a2020202020202020202020203c64697620636c6173733d2261637475616c223e3c7374726f6e673e61637475616c3c2f7374726f6e673e3c7072653e247b65736361706548544d4c286661696c7572652e41637475616c297d3c2f7072653e3c2f6469763e0a202020202020202020203c2f6469763e60203a2027277d0a20202020202020202020247b28646966662e6c656e677468203e203029203f20603c70726520636c6173733d2264696666223e247b646966662e6d617028286c696e6529203d3e20603c7370616e20636c6173733d22646966664c696e6520247b6c696e652e4b696e647d223e247b65736361706548544d4c286c696e652e54657874297d3c2f7370616e3e60292e6a6f696e28275c6e27297d3c2f7072653e60203a2027277d0a20202020202020202020247b286572726f7254726163652e6c656e677468203e203029203f20603c64697620636c6173733d226572726f725472616365223e3c7374726f6e673e4572726f722054726163653a3c2f7374726f6e673e20247b6572726f7254726163652e6d61702874726163654c6f636174696f6e48544d4c292e6a6f696e28272027297d3c2f6469763e60203a2027277d0a20202020202020203c2f6469763e600a202020207d290a202020206661696c757265734469762e696e6e657248544d4c203d206661696c7572657348544d4c0a2020202072657475726e206661696c757265734469760a20207d0a0a20202f2a2a0a2020202a2052657475726e7320616e20656c656d656e742073686f77696e672074686520636f6e666c696374696e67206163636573736573206f662061206461746120726163652c2074686520676f726f7574696e6573206f662074686520616363657373657320616e64207468652074657374730a2020202a207265706f7274696e672074686520726163652e2054686520737461636b73206f66207468652061636365737365732061726520657870616e6465642c2074686520737461636b73206f662074686520676f726f7574696e65206372656174696f6e20736974657320617265206e6f742e0a2020202a2040706172616d207b44617461526163657d20726163650a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e2072616365456c656d656e74287261636529207b0a20202020636f6e73742072616365446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020726163654469762e636c6173734c6973742e6164642827646174615261636527290a20202020726163654469762e6964203d20726163652e49440a20202020636f6e7374207465737473203d202f2a2a4074797065207b41727261792e3c52616365546573743e7d2a2f2028726163652e5465737473203d3d206e756c6c29203f205b5d203a20726163652e54657374730a202020206c6574207261636548544d4c203d202f2a2a4074797065207b737472696e677d2a2f20603c64697620636c6173733d22726163655469746c65223e3c7374726f6e673e4461746120726163652023247b726163652e49442e7265706c6163652827726163652d272c202727297d3c2f7374726f6e673e0a2020202020202020247b28726163652e4c6f636174696f6e20213d3d20272729203f20603c7370616e20636c6173733d22726163654c6f636174696f6e223e247b65736361706548544d4c28726163652e4c6f636174696f6e297d3c2f7370616e3e60203a2027277d3c2f6469763e0a20202020202020203c64697620636c6173733d22726163655465737473223e3c7374726f6e673e5265706f727465642062793a3c2f7374726f6e673e20247b74657374732e6d617028287465737429203d3e2060247b65736361706548544d4c28746573742e546573744e616d65297d2028247b65736361706548544d4c28746573742e5061636b616765297d2960292e6a6f696e28272c2027297d3c2f6469763e600a20202020636f6e7374206163636573736573203d202f2a2a4074797065207b41727261792e3c526163654163636573733e7d2a2f2028726163652e4163636573736573203d3d206e756c6c29203f205b5d203a20726163652e41636365737365730a2020202061636365737365732e666f7245616368282861636365737329203d3e207b0a2020202020207261636548544d4c202b3d20603c64657461696c7320636c6173733d227261636541636365737322206f70656e3e0a202020202020202020203c73756d6d6172793e247b65736361706548544d4c286163636573732e4465736372697074696f6e297d3c2f73756d6d6172793e0a20202020202020202020247b737461636b4672616d657348544d4c28286163636573732e4672616d6573203d3d206e756c6c29203f205b5d203a206163636573732e4672616d6573297d0a20202020202020203c2f64657461696c733e600a202020207d290a20202020636f6e737420676f726f7574696e6573203d202f2a2a4074797065207b41727261792e3c476f726f7574696e65537461636b3e7d2a2f2028726163652e476f726f7574696e6573203d3d206e756c6c29203f205b5d203a20726163652e476f726f7574696e65730a20202020676f726f7574696e65732e666f72456163682828676f726f7574696e6529203d3e207b0a2020202020207261636548544d4c202b3d20603c64657461696c7320636c6173733d22676f726f7574696e65223e0a202020202020202020203c73756d6d6172793e476f726f7574696e6520247b676f726f7574696e652e49447d2028247b65736361706548544d4c28676f726f7574696e652e5374617465297d2920637265617465642061743c2f73756d6d6172793e0a20202020202020202020247b737461636b4672616d657348544d4c2828676f726f7574696e652e4672616d6573203d3d206e756c6c29203f205b5d203a20676f726f7574696e652e4672616d6573297d0a20202020202020203c2f64657461696c733e600a202020207d290a20202020726163654469762e696e6e657248544d4c203d207261636548544d4c0a2020202072657475726e20726163654469760a20207d0a0a20202f2a2a0a2020202a2052657475726e7320616e20656c656d656e74206c696e6b696e6720746f207468652064617461207261636573207265706f7274656420696e20746865206f7574707574206f66206120746573742e0a2020202a2040706172616d207b41727261792e3c737472696e673e7d20726163654944730a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e20746573745261636573456c656d656e74287261636549447329207b0a20202020636f6e7374207261636573446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202072616365734469762e636c6173734c6973742e6164642827726163657327290a2020202072616365734469762e696e6e657248544d4c203d20603c7374726f6e673e446174612072616365733a3c2f7374726f6e673e20247b726163654944732e6d61702828696429203d3e20603c6120687265663d2223247b69647d223e23247b69642e7265706c6163652827726163652d272c202727297d3c2f613e60292e6a6f696e28272027297d600a2020202072657475726e2072616365734469760a20207d0a0a20202f2a2a0a2020202a2052657475726e7320616e20656c656d656e742073686f77696e6720746865206661696c696e6720696e707574206f6620612066757a7a207465737420616e642074686520636f6d6d616e6420726570726f647563696e6720746865206661696c7572652e0a2020202a2040706172616d207b46757a7a4661696c7572657d2066757a7a4661696c7572650a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e2066757a7a4661696c757265456c656d656e742866757a7a4661696c75726529207b0a20202020636f6e73742066757a7a4661696c757265446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202066757a7a4661696c7572654469762e636c6173734c6973742e616464282766757a7a4661696c75726527290a20202020636f6e737420696e70757446696c65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020696e70757446696c654469762e636c6173734c6973742e6164642827696e70757446696c6527290a20202020696e70757446696c654469762e696e6e657248544d4c203d20603c7374726f6e673e4661696c696e6720696e7075743a3c2f7374726f6e673e20600a20202020696e70757446696c654469762e696e7365727441646a6163656e745465787428276265666f7265656e64272c202866757a7a4661696c7572652e496e70757446696c65203d3d3d20272729203f20277365656420636f7270757320656e747279206164646564207769746820662e41646427203a2066757a7a4661696c7572652e496e70757446696c65290a2020202066757a7a4661696c7572654469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20696e70757446696c65446976290a202020206966202866757a7a4661696c7572652e496e70757420213d3d20272729207b0a202020202020636f6e737420696e707574507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a202020202020696e7075745072652e636c6173734c6973742e6164642827696e70757427290a202020202020696e7075745072652e74657874436f6e74656e74203d2066757a7a4661696c7572652e496e7075740a20202020202066757a7a4661696c7572654469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20696e707574507265290a202020207d0a20202020636f6e737420726570726f436f6d6d616e64446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020726570726f436f6d6d616e644469762e636c6173734c6973742e6164642827726570726f436f6d6d616e6427290a20202020726570726f436f6d6d616e644469762e696e6e657248544d4c203d20603c7374726f6e673e546f2072652d72756e3a3c2f7374726f6e673e20600a20202020636f6e737420726570726f436f6d6d616e64436f6465203d20646f63756d656e742e637265617465456c656d656e742827636f646527290a20202020726570726f436f6d6d616e64436f64652e74657874436f6e74656e74203d2066757a7a4661696c7572652e526570726f436f6d6d616e640a20202020726570726f436f6d6d616e644469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20726570726f436f6d6d616e64436f6465290a2020202066757a7a4661696c7572654469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20726570726f436f6d6d616e64446976290a2020202072657475726e2066757a7a4661696c7572654469760a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206d61726b7570206f6620612073696e676c6520726f7720696e2074686520746573742067726f7570206c6973742e0a2020202a2040706172616d207b546573745374617475737d2074657374526573756c740a2020202a2040706172616d207b737472696e677d207465737449640a2020202a2040706172616d207b6e756d6265727d20696e6465780a2020202a2040706172616d207b737472696e677c6e756c6c7d20737562496e6465780a2020202a2040706172616d207b737472696e677d207469746c6520546865207469746c65206f662074686520726f772c206573636170656420627920746869732066756e6374696f6e2073696e63652073756274657374206e616d65732061726520667265652d666f726d20746578742e0a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e207465737447726f7570526f7748544d4c2874657374526573756c742c207465737449642c20696e6465782c20737562496e6465782c207469746c6529207b0a20202020636f6e73742074657374506173736564203d202f2a2a4074797065207b626f6f6c65616e7d2a2f2074657374526573756c742e5061737365640a20202020636f6e73742074657374536b6970706564203d202f2a2a4074797065207b626f6f6c65616e7d2a2f2074657374526573756c742e536b69707065640a202020206c65742074657374506173736564537461747573203d202f2a2a4074797065207b737472696e677d2a2f20287465737450617373656429203f202727203a202874657374536b6970706564203f2027736b697070656427203a20276661696c656427290a202020206c6574207465737453746174757349636f6e203d202f2a2a4074797065207b737472696e677d2a2f20287465737450617373656429203f202726636865636b3b27203a202874657374536b6970706564203f202726646173683b27203a20272663726f73733b27290a202020206966202874657374526573756c742e54696d65644f757429207b0a20202020202074657374506173736564537461747573203d20276661696c65642074696d65646f7574270a2020202020207465737453746174757349636f6e203d20272623383938373b270a202020207d20656c7365206966202874657374526573756c742e496e636f6d706c65746529207b0a20202020202074657374506173736564537461747573203d2027696e636f6d706c657465270a2020202020207465737453746174757349636f6e203d20273f270a202020207d0a20202020636f6e737420737562496e64657841747472203d202f2a2a4074797065207b737472696e677d2a2f2028737562496e646578203d3d206e756c6c29203f202727203a206020646174612d737562696e6465783d22247b737562496e6465787d22600a202020206c65742073756274657374546f67676c65203d202f2a2a4074797065207b737472696e677d2a2f2027270a202020206c6574207375627465737453756d6d617279203d202f2a2a4074797065207b737472696e677d2a2f2027270a202020206966202874657374526573756c742e537562746573747320213d206e756c6c2026262074657374526573756c742e53756274657374732e6c656e677468203e203029207b0a20202020202073756274657374546f67676c65203d20603c7370616e20636c6173733d2273756274657374546f67676c65223e2623393635363b3c2f7370616e3e600a2020202020207375627465737453756d6d617279203d20603c7370616e20636c6173733d227375627465737453756d6d617279223e0a202020202020202020203c7370616e20636c6173733d22706173736564223e26636865636b3b20247b74657374526573756c742e4e756d4f6653756274657374735061737365647d3c2f7370616e3e0a202020202020202020203c7370616e20636c6173733d22736b6970706564223e26646173683b20247b74657374526573756c742e4e756d4f665375627465737473536b69707065647d3c2f7370616e3e0a202020202020202020203c7370616e20636c6173733d226661696c6564223e2663726f73733b20247b74657374526573756c742e4e756d4f6653756274657374734661696c65647d3c2f7370616e3e0a20202020202020202020247b2874657374526573756c742e4e756d4f665375627465737473496e636f6d706c657465203e203029203f20603c7370616e20636c6173733d22696e636f6d706c657465223e3f20247b74657374526573756c742e4e756d4f665375627465737473496e636f6d706c6574657d3c2f7370616e3e60203a2027277d0a20202020202020203c2f7370616e3e600a202020207d0a20202020636f6e737420666c616b794261646765203d202f2a2a4074797065207b737472696e677d2a2f202874657374526573756c742e466c616b7929203f20603c7370616e20636c6173733d22666c616b794261646765223e666c616b793c2f7370616e3e60203a2027270a20202020636f6e73742066757a7a4261646765203d202f2a2a4074797065207b737472696e677d2a2f202874657374526573756c742e46757a7a29203f20603c7370616e20636c6173733d2266757a7a4261646765223e66757a7a3c2f7370616e3e60203a2027270a20202020636f6e737420726163654261646765203d202f2a2a4074797065207b737472696e677d2a2f202874657374526573756c742e526163657320213d206e756c6c2026262074657374526573756c742e52616365732e6c656e677468203e203029203f20603c7370616e20636c6173733d22726163654261646765223e6461746120726163653c2f7370616e3e60203a2027270a20202020636f6e7374206661696c75726553756d6d617279203d202f2a2a4074797065207b737472696e677d2a2f202874657374526573756c742e4661696c75726553756d6d61727929203f20603c7370616e20636c6173733d226661696c75726553756d6d617279223e247b65736361706548544d4c2874657374526573756c742e4661696c75726553756d6d617279297d3c2f7370616e3e60203a2027270a2020202072657475726e20603c64697620636c6173733d227465737447726f7570526f7720247b746573745061737365645374617475737d2220646174612d67726f757069643d22247b7465737449647d2220646174612d696e6465783d22247b696e6465787d22247b737562496e646578417474727d3e0a20202020202020203c7370616e20636c6173733d227465737453746174757320247b746573745061737365645374617475737d223e247b7465737453746174757349636f6e7d3c2f7370616e3e0a2020202020202020247b73756274657374546f67676c657d3c7370616e20636c6173733d22746573745469746c65223e247b65736361706548544d4c287469746c65297d247b666c616b7942616467657d247b66757a7a42616467657d247b7261636542616467657d247b7375627465737453756d6d6172797d3c2f7370616e3e247b6661696c75726553756d6d6172797d0a20202020202020203c7370616e20636c6173733d22746573744475726174696f6e223e3c7370616e3e247b74657374526573756c742e456c617073656454696d657d73203c2f7370616e3ee28fb13c2f7370616e3e0a2020202020203c2f6469763e600a20207d0a0a0a2020636f6e737420676f546573745265706f7274203d207b0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e206f6e65206f662074686520746573742067726f75702064697620656c656d656e74732e0a20202020202a2040706172616d207b48544d4c456c656d656e747d207461726765742054686520656c656d656e74206173736f63696174656420776974682074686520746573742067726f75702e0a20202020202a2040706172616d207b626f6f6c65616e7d2073686966744b657920496620707265737365642c20616c6c206f6620746573742064657461696c206173736f63696174656420746f2074686520746573742067726f75702069732073686f776e2e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2040706172616d207b53656c65637465644974656d737d2073656c65637465644974656d730a20202020202a2040706172616d207b66756e6374696f6e287461726765743a20456c656d656e742c20646174613a2054657374526573756c7473297d207465737447726f75704c69737448616e646c65720a20202020202a2f0a2020202074657374526573756c7473436c69636b48616e646c65723a2066756e6374696f6e20287461726765742c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073686966744b65792c0a202020202020202020202020202020202020202020202020202020202020202020202020202020646174612c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a2020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c69737448616e646c657229207b0a0a202020202020696620287461726765742e636c6173734c6973742e636f6e7461696e73282774657374526573756c7447726f75702729203d3d3d2066616c736529207b0a202020202020202072657475726e0a2020202020207d0a2020202020206966202873656c65637465644974656d732e74657374526573756c747320213d206e756c6c29207b0a20202020202020206c65742074657374526573756c7473456c656d656e74203d202f2a2a4074797065207b48544d4c456c656d656e747d2a2f2073656c65637465644974656d732e74657374526573756c74730a202020202020202074657374526573756c7473456c656d656e742e636c6173734c6973742e72656d6f7665282273656c656374656422290a202020202020202074657374526573756c7473456c656d656e742e7374796c652e6261636b67726f756e64436f6c6f72203d2073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f720a2020202020207d0a202020202020636f6e7374207465737447726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f207461726765742e69640a20202020202069662028287461726765742e6964203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d5b2754657374526573756c7473275d203d3d3d20756e646566696e65642929207b0a202020202020202072657475726e0a2020202020207d0a202020202020636f6e73742074657374526573756c7473203d202f2a2a4074797065207b54657374526573756c74737d2a2f20646174615b7465737447726f757049645d5b2754657374526573756c7473275d0a2020202020206c6574207465737447726f75704c697374203d202f2a2a4074797065207b737472696e677d2a2f2027270a20202020202073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f72203d20676574436f6d70757465645374796c6528746172676574292e67657450726f706572747956616c756528276261636b67726f756e642d636f6c6f7227290a20202020202073656c65637465644974656d732e74657374526573756c7473203d207461726765740a2020202020207461726765742e636c6173734c6973742e616464282273656c656374656422290a202020202020666f7220286c65742069203d20303b2069203c2074657374526573756c74732e6c656e6774683b20692b2b29207b0a2020202020202020636f6e73742074657374526573756c74203d202f2a2a4074797065207b546573745374617475737d2a2f2074657374526573756c74735b695d0a2020202020202020636f6e737420746573744964203d202f2a2a4074797065207b737472696e677d2a2f207461726765742e617474726962757465735b276964275d2e76616c75650a20202020202020207465737447726f75704c697374202b3d207465737447726f7570526f7748544d4c2874657374526573756c742c207465737449642c20692c206e756c6c2c2074657374526573756c742e546573744e616d65290a2020202020207d0a202020202020636f6e7374207465737447726f75704c697374456c656d203d20656c656d656e74732e7465737447726f75704c697374456c656d0a2020202020207465737447726f75704c697374456c656d2e696e6e657248544d4c203d2027270a2020202020207465737447726f75704c697374456c656d2e696e6e657248544d4c203d207465737447726f75704c6973740a0a2020202020206966202873686966744b657929207b0a20202020202020207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e7465737447726f7570526f7727290a202020202020202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e207465737447726f75704c69737448616e646c657228656c656d2c206461746129290a2020202020207d20656c7365206966202874657374526573756c74732e6c656e677468203d3d3d203129207b0a20202020202020207465737447726f75704c69737448616e646c6572287465737447726f75704c697374456c656d2e717565727953656c6563746f7228272e7465737447726f7570526f7727292c2064617461290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a0a20202020202a2040706172616d207b456c656d656e747d207461726765740a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a202020207465737447726f75704c69737448616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e73742061747472696273203d207461726765745b2761747472696275746573275d0a20202020202069662028617474726962732e6861734f776e50726f70657274792827646174612d67726f75706964272929207b0a2020202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f2067657454657374537461747573287461726765742c2064617461290a2020202020202020636f6e737420746573744f7574707574446976203d202f2a2a4074797065207b48544d4c446976456c656d656e747d2a2f207461726765742e717565727953656c6563746f7228276469762e746573744f757470757427290a0a202020202020202069662028746573744f7574707574446976203d3d206e756c6c29207b0a20202020202020202020636f6e737420746573744f7574707574446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202020202020746573744f75747075744469762e636c6173734c6973742e6164642827746573744f757470757427290a20202020202020202020636f6e737420636f6e736f6c65507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a20202020202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827636f6e736f6c6527290a20202020202020202020636f6e7374207465737444657461696c446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737444657461696c4469762e636c6173734c6973742e61646428277465737444657461696c27290a20202020202020202020636f6e7374207061636b6167654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207061636b6167654e616d654469762e636c6173734c6973742e61646428277061636b61676527290a202020202020202020207061636b6167654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e5061636b6167653a3c2f7374726f6e673e20247b746573745374617475732e5061636b6167657d600a20202020202020202020636f6e7374207465737446696c654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737446696c654e616d654469762e636c6173734c6973742e616464282766696c656e616d6527290a2020202020202020202069662028746573745374617475732e5465737446696c654e616d652e7472696d2829203d3d3d20222229207b0a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e46696c656e616d653a3c2f7374726f6e673e206e2f6120266e6273703b266e6273703b600a202020202020202020207d20656c7365207b0a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c203d20603c7374726f6e673e46696c656e616d653a3c2f7374726f6e673e20247b746573745374617475732e5465737446696c654e616d657d20266e6273703b266e6273703b600a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c202b3d20603c7374726f6e673e4c696e653a3c2f7374726f6e673e20247b746573745374617475732e5465737446756e6374696f6e44657461696c2e4c696e657d20600a2020202020202020202020207465737446696c654e616d654469762e696e6e657248544d4c202b3d20603c7374726f6e673e436f6c3a3c2f7374726f6e673e20247b746573745374617475732e5465737446756e6374696f6e44657461696c2e436f6c7d600a202020202020202020207d0a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207061636b6167654e616d65446976290a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737446696c654e616d65446976290a2020202020202020202069662028746573745374617475732e536f7572636520213d206e756c6c20262620746573745374617475732e536f7572636520213d3d20272729207b0a202020202020202020202020636f6e737420736f75726365446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020202020736f757263654469762e636c6173734c6973742e6164642827736f7572636527290a202020202020202020202020736f757263654469762e696e6e657248544d4c203d20603c7374726f6e673e536f757263653a3c2f7374726f6e673e20247b65736361706548544d4c28746573745374617475732e536f75726365297d600a2020202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20736f75726365446976290a202020202020202020207d0a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20636f6e736f6c65507265290a20202020202020202020696620286f7574707574446966667348544d4c28746573745374617475732e4f75747075742920213d206e756c6c29207b0a202020202020202020202020636f6e7374207261774f7574707574546f67676c65203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a2020202020202020202020207261774f7574707574546f67676c652e636c6173734c6973742e61646428277261774f7574707574546f67676c6527290a2020202020202020202020207261774f7574707574546f67676c652e74657874436f6e74656e74203d202753686f7720726177206f7574707574270a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c207261774f7574707574546f67676c65290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e417474656d70747320213d206e756c6c20262620746573745374617475732e417474656d7074732e6c656e677468203e203129207b0a202020202020202020202020636f6e737420617474656d707454616273446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020202020617474656d7074546162734469762e636c6173734c6973742e6164642827617474656d70745461627327290a202020202020202020202020617474656d7074546162734469762e696e6e657248544d4c203d20617474656d70745461627348544d4c2874657374537461747573290a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20617474656d707454616273446976290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e546573746966794661696c7572657320213d206e756c6c20262620746573745374617475732e546573746966794661696c757265732e6c656e677468203e203029207b0a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20746573746966794661696c75726573456c656d656e7428746573745374617475732e546573746966794661696c7572657329290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e50616e696320213d206e756c6c29207b0a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c2070616e6963456c656d656e7428746573745374617475732e50616e696329290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e526163657320213d206e756c6c20262620746573745374617475732e52616365732e6c656e677468203e203029207b0a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20746573745261636573456c656d656e7428746573745374617475732e526163657329290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e46757a7a4661696c75726520213d206e756c6c29207b0a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c2066757a7a4661696c757265456c656d656e7428746573745374617475732e46757a7a4661696c75726529290a202020202020202020207d0a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737444657461696c446976290a202020202020202020207461726765742e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20746573744f7574707574446976290a0a20202020202020202020736574436f6e736f6c6553746174757328636f6e736f6c655072652c2074657374537461747573290a20202020202020202020736574436f6e736f6c654f757470757428636f6e736f6c655072652c20746573745374617475732e4f7574707574290a20202020202020207d20656c7365207b0a20202020202020202020746573744f75747075744469762e72656d6f766528290a20202020202020207d0a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e206f6e65206f662074686520617474656d70742074616273206f662061207465737420746861742072616e206d6f7265207468616e206f6e63652e2053686f777320746865206f7574707574206f660a20202020202a207468652073656c656374656420617474656d70742c206f7220746865206f7574707574206f6620616c6c20617474656d7074732e0a20202020202a2040706172616d207b456c656d656e747d207461726765742054686520617474656d70742074616220656c656d656e742e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a20202020617474656d707454616248616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e737420746573744f7574707574446976203d202f2a2a4074797065207b456c656d656e747d2a2f207461726765742e636c6f7365737428276469762e746573744f757470757427290a202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f206765745465737453746174757328746573744f75747075744469762e706172656e74456c656d656e742c2064617461290a202020202020636f6e737420636f6e736f6c65507265203d202f2a2a4074797065207b48544d4c456c656d656e747d2a2f20746573744f75747075744469762e717565727953656c6563746f7228277072652e636f6e736f6c6527290a202020202020636f6e737420617474656d7074496e646578203d202f2a2a4074797065207b737472696e677d2a2f207461726765742e617474726962757465735b27646174612d617474656d7074275d2e76616c75650a202020202020636f6e737420737461747573203d202f2a2a4074797065207b546573745374617475737c54657374417474656d70747d2a2f2028617474656d7074496e646578203d3d3d20272729203f2074657374537461747573203a20746573745374617475732e417474656d7074735b617474656d7074496e6465785d0a202020202020746573744f75747075744469762e717565727953656c6563746f72416c6c28272e617474656d707454616227290a202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e20656c656d2e636c6173734c6973742e72656d6f7665282773656c65637465642729290a2020202020207461726765742e636c6173734c6973742e616464282773656c656374656427290a202020202020736574436f6e736f6c6553746174757328636f6e736f6c655072652c20737461747573290a202020202020736574436f6e736f6c654f757470757428636f6e736f6c655072652c207374617475732e4f7574707574290a202020207d2c0a0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e2074686520746f67676c65206f6620746865206f757470757420636f6e736f6c65206f66206120746573742077686f7365206661696c757265206d6573736167657320636f6e7461696e2064696666732e0a20202020202a205377697463686573206265747765656e2074686520686967686c69676874656420646966667320616e642074686520726177206f7574707574206f66207468652073656c656374656420617474656d70742e0a20202020202a2040706172616d207b456c656d656e747d207461726765742054686520746f67676c6520656c656d656e742e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a202020207261774f7574707574546f67676c6548616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e737420746573744f7574707574446976203d202f2a2a4074797065207b456c656d656e747d2a2f207461726765742e636c6f7365737428276469762e746573744f757470757427290a202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f206765745465737453746174757328746573744f75747075744469762e706172656e74456c656d656e742c2064617461290a202020202020636f6e737420636f6e736f6c65507265203d202f2a2a4074797065207b48544d4c456c656d656e747d2a2f20746573744f75747075744469762e717565727953656c6563746f7228277072652e636f6e736f6c6527290a202020202020636f6e73742073656c6563746564546162203d202f2a2a4074797065207b456c656d656e747c6e756c6c7d2a2f20746573744f75747075744469762e717565727953656c6563746f7228272e617474656d70745461622e73656c656374656427290a202020202020636f6e737420617474656d7074496e646578203d202f2a2a4074797065207b737472696e677d2a2f202873656c6563746564546162203d3d206e756c6c29203f202727203a2073656c65637465645461622e617474726962757465735b27646174612d617474656d7074275d2e76616c75650a202020202020636f6e737420737461747573203d202f2a2a4074797065207b546573745374617475737c54657374417474656d70747d2a2f2028617474656d7074496e646578203d3d3d20272729203f2074657374537461747573203a20746573745374617475732e417474656d7074735b617474656d7074496e6465785d0a202020202020636f6e737420726177203d202f2a2a4074797065207b626f6f6c65616e7d2a2f20636f6e736f6c655072652e636c6173734c6973742e746f67676c65282772617727290a2020202020207461726765742e74657874436f6e74656e74203d20726177203f202753686f7720686967686c696768746564206f757470757427203a202753686f7720726177206f7574707574270a202020202020736574436f6e736f6c654f757470757428636f6e736f6c655072652c207374617475732e4f7574707574290a202020207d2c0a0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e2074686520746f67676c65206f66206120746573742074686174206861732073756274657374732e2054686520726f7773206f66207468652073756274657374732061726520637265617465640a20202020202a207468652066697273742074696d6520746865207465737420697320657870616e64656420616e64206172652073686f776e206f722068696464656e206f6e2073756273657175656e7420636c69636b732e0a20202020202a2040706172616d207b456c656d656e747d207461726765742054686520746f67676c6520656c656d656e7420696e73696465206f662074686520746573742067726f757020726f772e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a2020202073756274657374546f67676c6548616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e7374207465737447726f7570526f77203d202f2a2a4074797065207b456c656d656e747d2a2f207461726765742e706172656e74456c656d656e740a202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f2067657454657374537461747573287465737447726f7570526f772c2064617461290a2020202020206c657420737562746573744c697374203d202f2a2a4074797065207b456c656d656e747d2a2f207465737447726f7570526f772e6e657874456c656d656e745369626c696e670a20202020202069662028737562746573744c697374203d3d206e756c6c207c7c20737562746573744c6973742e636c6173734c6973742e636f6e7461696e732827737562746573744c6973742729203d3d3d2066616c736529207b0a2020202020202020636f6e73742061747472696273203d207465737447726f7570526f775b2761747472696275746573275d0a2020202020202020636f6e737420746573744964203d202f2a2a4074797065207b737472696e677d2a2f20617474726962735b27646174612d67726f75706964275d2e76616c75650a2020202020202020636f6e73742074657374496e646578203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d696e646578275d2e76616c75650a2020202020202020636f6e737420706172656e74537562496e646578203d202f2a2a4074797065207b737472696e677c6e756c6c7d2a2f20617474726962732e6861734f776e50726f70657274792827646174612d737562696e6465782729203f20617474726962735b27646174612d737562696e646578275d2e76616c7565203a206e756c6c0a20202020202020206c65742073756274657374526f7773203d202f2a2a4074797065207b737472696e677d2a2f2027270a2020202020202020666f7220286c65742069203d20303b2069203c20746573745374617475732e53756274657374732e6c656e6774683b20692b2b29207b0a20202020202020202020636f6e73742073756274657374203d202f2a2a4074797065207b546573745374617475737d2a2f20746573745374617475732e53756274657374735b695d0a20202020202020202020636f6e737420737562496e646578203d202f2a2a4074797065207b737472696e677d2a2f2028706172656e74537562496e646578203d3d206e756c6c29203f2060247b697d60203a2060247b706172656e74537562496e6465787d2e247b697d600a20202020202020202020636f6e7374207469746c65203d202f2a2a4074797065207b737472696e677d2a2f20737562746573742e546573744e616d652e737562737472696e6728746573745374617475732e546573744e616d652e6c656e677468202b2031290a2020202020202020202073756274657374526f7773202b3d207465737447726f7570526f7748544d4c28737562746573742c207465737449642c2074657374496e6465782c20737562496e6465782c207469746c65290a20202020202020207d0a2020202020202020737562746573744c697374203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202020202020737562746573744c6973742e636c6173734c6973742e6164642827737562746573744c69737427290a2020202020202020737562746573744c6973742e696e6e657248544d4c203d2073756274657374526f77730a20202020202020207465737447726f7570526f772e696e7365727441646a6163656e74456c656d656e7428276166746572656e64272c20737562746573744c697374290a20202020202020207461726765742e636c6173734c6973742e6164642827657870616e64656427290a2020202020207d20656c73652069662028737562746573744c6973742e636c6173734c6973742e746f67676c652827636f6c6c6170736564272929207b0a20202020202020207461726765742e636c6173734c6973742e72656d6f76652827657870616e64656427290a2020202020207d20656c7365207b0a20202020202020207461726765742e636c6173734c6973742e6164642827657870616e64656427290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e206120636f6c756d6e20686561646572206f66207468652062656e63686d61726b207461626c652e20536f7274732074686520726f7773206279207468652076616c756573206f66207468650a20202020202a20636f6c756d6e2c20617363656e64696e67206f6e2074686520666972737420636c69636b20616e642064657363656e64696e67206f6e20746865206e6578742e2042656e63686d61726b73207468617420646964206e6f74207265706f727420612076616c7565206172650a20202020202a20616c7761797320706c61636564206c6173742e0a20202020202a2040706172616d207b456c656d656e747d207461726765742054686520636f6c756d6e2068656164657220656c656d656e742e0a20202020202a2f0a2020202062656e63686d61726b5461626c6548616e646c65723a2066756e6374696f6e202874617267657429207b0a202020202020636f6e737420686561646572526f77203d202f2a2a4074797065207b456c656d656e747d2a2f207461726765742e706172656e74456c656d656e740a202020202020636f6e737420636f6c756d6e496e646578203d202f2a2a4074797065207b6e756d6265727d2a2f2041727261792e70726f746f747970652e696e6465784f662e63616c6c28686561646572526f772e6368696c6472656e2c20746172676574290a202020202020636f6e737420617363656e64696e67203d202f2a2a4074797065207b626f6f6c65616e7d2a2f20217461726765742e636c6173734c6973742e636f6e7461696e732827617363656e64696e6727290a20202020202041727261792e70726f746f747970652e666f72456163682e63616c6c28686561646572526f772e6368696c6472656e2c2028656c656d29203d3e20656c656d2e636c6173734c6973742e72656d6f76652827617363656e64696e67272c202764657363656e64696e672729290a2020202020207461726765742e636c6173734c6973742e61646428617363656e64696e67203f2027617363656e64696e6727203a202764657363656e64696e6727290a0a202020202020636f6e73742074626f6479203d202f2a2a4074797065207b456c656d656e747d2a2f207461726765742e636c6f7365737428277461626c6527292e717565727953656c6563746f72282774626f647927290a202020202020636f6e737420726f7773203d202f2a2a4074797065207b41727261792e3c456c656d656e743e7d2a2f2041727261792e66726f6d2874626f64792e717565727953656c6563746f72416c6c282774722729290a202020202020636f6e73742063656c6c56616c7565203d2028726f7729203d3e20726f772e6368696c6472656e5b636f6c756d6e496e6465785d2e74657874436f6e74656e742e7472696d28290a202020202020726f77732e736f72742828726f77412c20726f774229203d3e207b0a2020202020202020636f6e73742061203d202f2a2a4074797065207b737472696e677d2a2f2063656c6c56616c756528726f7741290a2020202020202020636f6e73742062203d202f2a2a4074797065207b737472696e677d2a2f2063656c6c56616c756528726f7742290a20202020202020206966202861203d3d3d202727207c7c2062203d3d3d20272729207b0a2020202020202020202072657475726e202861203d3d3d20272729202d202862203d3d3d202727290a20202020202020207d0a2020202020202020636f6e7374206e756d41203d202f2a2a4074797065207b6e756d6265727d2a2f204e756d6265722861290a2020202020202020636f6e7374206e756d42203d202f2a2a4074797065207b6e756d6265727d2a2f204e756d6265722862290a2020202020202020636f6e7374206f72646572203d202f2a2a4074797065207b6e756d6265727d2a2f202869734e614e286e756d4129207c7c2069734e614e286e756d422929203f20612e6c6f63616c65436f6d70617265286229203a206e756d41202d206e756d420a202020202020202072657475726e20617363656e64696e67203f206f72646572203a202d6f726465720a2020202020207d290a202020202020726f77732e666f72456163682828726f7729203d3e2074626f64792e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20726f7729290a202020207d2c0a0a202020202f2a2a0a20202020202a204f70656e732074686520616e6e6f746174656420736f757263652066696c65207265666572656e636564206279206120636f766572616765206c696e6b206f6620746865207061636b616765206c6973742e205468652062726f77736572207363726f6c6c7320746f207468650a20202020202a2066696c6520627920666f6c6c6f77696e6720746865206c696e6b2e0a20202020202a2040706172616d207b456c656d656e747d207461726765740a20202020202a2f0a20202020636f7665726167654c696e6b48616e646c65723a2066756e6374696f6e202874617267657429207b0a202020202020636f6e737420636f76657261676546696c65456c656d203d202f2a2a4074797065207b48544d4c44657461696c73456c656d656e747d2a2f20646f63756d656e742e676574456c656d656e7442794964287461726765742e67657441747472696275746528276872656627292e737562737472696e67283129290a20202020202069662028636f76657261676546696c65456c656d20213d206e756c6c29207b0a2020202020202020636f76657261676546696c65456c656d2e6f70656e203d20747275650a2020202020207d0a202020207d0a20207d0a0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a20202f2f7c20202020736574757020444f4d206576656e7473202020207c0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a2020656c656d656e74732e74657374526573756c7473456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e0a202020202020202020202020676f546573745265706f72742e74657374526573756c7473436c69636b48616e646c6572282f2a2a4074797065207b48544d4c456c656d656e747d2a2f206164644576656e7444617461286576656e74292e646174612e7461726765742c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020206576656e742e73686966744b65792c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020656c656d656e74732e646174612c0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c657229290a0a2020656c656d656e74732e7465737447726f75704c697374456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e207b0a202020202020202020202020636f6e737420746172676574203d202f2a2a4074797065207b456c656d656e747d2a2f206576656e742e7461726765740a202020202020202020202020696620287461726765742e636c6173734c6973742e636f6e7461696e73282773756274657374546f67676c65272929207b0a2020202020202020202020202020676f546573745265706f72742e73756274657374546f67676c6548616e646c6572287461726765742c20656c656d656e74732e64617461290a2020202020202020202020207d20656c736520696620287461726765742e636c6173734c6973742e636f6e7461696e732827617474656d7074546162272929207b0a2020202020202020202020202020676f546573745265706f72742e617474656d707454616248616e646c6572287461726765742c20656c656d656e74732e64617461290a2020202020202020202020207d20656c736520696620287461726765742e636c6173734c6973742e636f6e7461696e7328277261774f7574707574546f67676c65272929207b0a2020202020202020202020202020676f546573745265706f72742e7261774f7574707574546f67676c6548616e646c6572287461726765742c20656c656d656e74732e64617461290a2020202020202020202020207d20656c7365207b0a2020202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c6572287461726765742c20656c656d656e74732e64617461290a2020202020202020202020207d0a202020202020202020207d290a0a202069662028656c656d656e74732e62656e63686d61726b5461626c65456c656d20213d206e756c6c29207b0a20202020656c656d656e74732e62656e63686d61726b5461626c65456c656d0a2020202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e207b0a2020202020202020202020202020636f6e737420746172676574203d202f2a2a4074797065207b456c656d656e747d2a2f206576656e742e7461726765740a2020202020202020202020202020696620287461726765742e7461674e616d65203d3d3d202754482729207b0a20202020202020202020202020202020676f546573745265706f72742e62656e63686d61726b5461626c6548616e646c657228746172676574290a20202020202020202020202020207d0a2020202020202020202020207d290a20207d0a0a202069662028656c656d656e74732e726163654c697374456c656d20213d206e756c6c20262620656c656d656e74732e726163657320213d206e756c6c29207b0a20202020656c656d656e74732e72616365732e666f724561636828287261636529203d3e20656c656d656e74732e726163654c697374456c656d2e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c2072616365456c656d656e7428726163652929290a20207d0a0a202069662028656c656d656e74732e7061636b6167654c697374456c656d20213d206e756c6c29207b0a20202020656c656d656e74732e7061636b6167654c697374456c656d0a2020202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e207b0a2020202020202020202020202020636f6e737420746172676574203d202f2a2a4074797065207b456c656d656e747d2a2f206576656e742e7461726765740a2020202020202020202020202020696620287461726765742e636c6173734c6973742e636f6e7461696e732827636f7665726167654c696e6b272929207b0a20202020202020202020202020202020676f546573745265706f72742e636f7665726167654c696e6b48616e646c657228746172676574290a20202020202020202020202020207d0a2020202020202020202020207d290a20207d0a0a202072657475726e20676f546573745265706f72740a7d0a`
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// fuzzFailure is a failing input of a fuzz test, either found while fuzzing or a failing entry of the seed corpus.
+type fuzzFailure struct {
+	// the input file relative to the directory of the package, e.g. "testdata/fuzz/FuzzParse/5ba1d3a7e2b0c4f9", empty
+	// for seed corpus entries added with f.Add
+	InputFile string
+	// the contents of the input file, empty if the file could not be read
+	Input        string
+	ReproCommand string
+}
+
+// the line printed by go test when fuzzing finds a failing input, e.g.
+// "    Failing input written to testdata/fuzz/FuzzParse/5ba1d3a7e2b0c4f9"
+var fuzzFailingInputRegexp = regexp.MustCompile(`^\s*Failing input written to (\S+)`)
+
+// isFuzzTest returns true for fuzz tests and the seed corpus entries they run as subtests.
+func isFuzzTest(testName string) bool {
+	return strings.HasPrefix(testName, "Fuzz")
+}
+
+// readFuzzFailures adds the failing input and a reproduction command to every failed fuzz test. A fuzz test
+// reports the input file it wrote when fuzzing finds a failure, a failed seed corpus entry is a subtest named after
+// its input file in testdata/fuzz, or "seed#N" for inputs added with f.Add. The input files are read from the
+// package directories reported by go list.
+func readFuzzFailures(allTests map[string]*testStatus, goListByPackage goListJSONByPackage) {
+	for _, status := range allTests {
+		if !status.Fuzz || status.Passed || status.Skipped {
+			continue
+		}
+		names := strings.Split(status.TestName, "/")
+		var failure *fuzzFailure
+		for _, output := range status.Output {
+			if match := fuzzFailingInputRegexp.FindStringSubmatch(output); match != nil {
+				failure = &fuzzFailure{InputFile: match[1]}
+				names = append(names[:1], filepath.Base(match[1]))
+			}
+		}
+		if failure == nil && len(names) == 2 {
+			failure = &fuzzFailure{}
+			if !strings.HasPrefix(names[1], "seed#") {
+				failure.InputFile = filepath.ToSlash(filepath.Join("testdata", "fuzz", names[0], names[1]))
+			}
+		}
+		if failure == nil {
+			continue
+		}
+		failure.ReproCommand = fmt.Sprintf("go test -run='^%s$/^%s$' %s", names[0], names[1], status.Package)
+		if goList := goListByPackage[status.Package]; goList != nil && failure.InputFile != "" {
+			if input, err := ioutil.ReadFile(filepath.Join(goList.Dir, filepath.FromSlash(failure.InputFile))); err == nil {
+				failure.Input = string(input)
+			}
+		}
+		status.FuzzFailure = failure
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadFuzzFailures(t *testing.T) {
+	assertions := assert.New(t)
+	packageDir, err := ioutil.TempDir("", "go-test-report-fuzz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(packageDir)
+	corpusDir := filepath.Join(packageDir, "testdata", "fuzz", "FuzzParse")
+	if err := os.MkdirAll(corpusDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	input := "go test fuzz v1\nstring(\"z000\")\n"
+	for _, name := range []string{"310637d0c4244160", "771e938e4458e983"} {
+		if err := ioutil.WriteFile(filepath.Join(corpusDir, name), []byte(input), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data := `{"Action":"run","Package":"foo","Test":"FuzzParse"}
+{"Action":"output","Package":"foo","Test":"FuzzParse","Output":"        foo_test.go:10: boom\n"}
+{"Action":"output","Package":"foo","Test":"FuzzParse","Output":"    Failing input written to testdata/fuzz/FuzzParse/310637d0c4244160\n"}
+{"Action":"output","Package":"foo","Test":"FuzzParse","Output":"    To re-run:\n"}
+{"Action":"fail","Package":"foo","Test":"FuzzParse","Elapsed":0.12}
+{"Action":"run","Package":"bar","Test":"FuzzParse"}
+{"Action":"run","Package":"bar","Test":"FuzzParse/seed#0"}
+{"Action":"pass","Package":"bar","Test":"FuzzParse/seed#0"}
+{"Action":"run","Package":"bar","Test":"FuzzParse/seed#1"}
+{"Action":"fail","Package":"bar","Test":"FuzzParse/seed#1"}
+{"Action":"run","Package":"bar","Test":"FuzzParse/771e938e4458e983"}
+{"Action":"fail","Package":"bar","Test":"FuzzParse/771e938e4458e983"}
+{"Action":"fail","Package":"bar","Test":"FuzzParse"}
+{"Action":"run","Package":"bar","Test":"TestParse"}
+{"Action":"fail","Package":"bar","Test":"TestParse"}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.True(allTests["bar.FuzzParse/seed#0"].Fuzz)
+	assertions.False(allTests["bar.TestParse"].Fuzz)
+
+	readFuzzFailures(allTests, goListJSONByPackage{
+		"foo": {ImportPath: "foo", Dir: packageDir},
+		"bar": {ImportPath: "bar", Dir: packageDir},
+	})
+	assertions.Equal(&fuzzFailure{
+		InputFile:    "testdata/fuzz/FuzzParse/310637d0c4244160",
+		Input:        input,
+		ReproCommand: "go test -run='^FuzzParse$/^310637d0c4244160$' foo",
+	}, allTests["foo.FuzzParse"].FuzzFailure)
+	assertions.Equal(&fuzzFailure{
+		ReproCommand: "go test -run='^FuzzParse$/^seed#1$' bar",
+	}, allTests["bar.FuzzParse/seed#1"].FuzzFailure)
+	assertions.Equal(&fuzzFailure{
+		InputFile:    "testdata/fuzz/FuzzParse/771e938e4458e983",
+		Input:        input,
+		ReproCommand: "go test -run='^FuzzParse$/^771e938e4458e983$' bar",
+	}, allTests["bar.FuzzParse/771e938e4458e983"].FuzzFailure)
+	assertions.Nil(allTests["bar.FuzzParse"].FuzzFailure)
+	assertions.Nil(allTests["bar.FuzzParse/seed#0"].FuzzFailure)
+	assertions.Nil(allTests["bar.TestParse"].FuzzFailure)
+
+	// the contents of the input file are left out if the package directory is unknown
+	allTests["foo.FuzzParse"].FuzzFailure = nil
+	readFuzzFailures(allTests, goListJSONByPackage{})
+	assertions.Equal("testdata/fuzz/FuzzParse/310637d0c4244160", allTests["foo.FuzzParse"].FuzzFailure.InputFile)
+	assertions.Empty(allTests["foo.FuzzParse"].FuzzFailure.Input)
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+)
+
+// jsonReportVersion is the version of the document written by the --json-out flag. It is incremented whenever a
+// field is removed or changes its meaning, adding new fields does not change the version.
+const jsonReportVersion = 1
+
+type (
+	// jsonReport is the document written by the --json-out flag. See the "JSON output" section of the README for
+	// a description of every field.
+	jsonReport struct {
+		Version       int
+		Title         string
+		ExecutionDate string
+		Duration      float64
+		Totals        jsonReportTotals
+		Packages      []*jsonReportPackage
+		Tests         []*jsonReportTest
+	}
+
+	jsonReportTotals struct {
+		Tests          int
+		Passed         int
+		Failed         int
+		Skipped        int
+		Incomplete     int
+		Flaky          int
+		Packages       int
+		PackagesFailed int
+		Coverage       *jsonReportCoverage
+	}
+
+	jsonReportPackage struct {
+		Name         string
+		Status       string
+		Elapsed      float64
+		NoTestFiles  bool
+		BuildFailed  bool
+		BuildOutput  []string
+		Output       []string
+		Sources      []string
+		Benchmarks   []*jsonReportBenchmark
+		Timeout      string
+		RunningTests []string
+		Coverage     *jsonReportCoverage
+	}
+
+	jsonReportCoverage struct {
+		Percent      float64
+		Statements   int
+		BelowMinimum bool
+	}
+
+	jsonReportBenchmark struct {
+		Name       string
+		Procs      int
+		Iterations int64
+		Metrics    map[string]float64
+		File       string
+		Line       int
+		Col        int
+	}
+
+	jsonReportTest struct {
+		Name           string
+		Package        string
+		Parent         string
+		Source         string
+		Status         string
+		FailureSummary string
+		Flaky          bool
+		TimedOut       bool
+		Elapsed        float64
+		Start          *time.Time
+		End            *time.Time
+		File           string
+		Line           int
+		Col            int
+		Output         []string
+		Attempts       []*jsonReportAttempt
+		Fuzz           bool
+		FuzzFailure    *jsonReportFuzzFailure
+	}
+
+	jsonReportFuzzFailure struct {
+		InputFile    string
+		Input        string
+		ReproCommand string
+	}
+
+	jsonReportAttempt struct {
+		Status  string
+		Elapsed float64
+		Output  []string
+	}
+)
+
+// generateJSONReport writes the processed test results, including the totals computed by generateReport, as a
+// versioned JSON document. generateReport must be invoked first.
+func generateJSONReport(tmplData *templateData, allTests map[string]*testStatus, writer io.Writer) error {
+	report := &jsonReport{
+		Version:       jsonReportVersion,
+		Title:         tmplData.ReportTitle,
+		ExecutionDate: tmplData.TestExecutionDate,
+		Duration:      tmplData.TestDuration.Seconds(),
+		Totals: jsonReportTotals{
+			Tests:          tmplData.NumOfTests,
+			Passed:         tmplData.NumOfTestPassed,
+			Failed:         tmplData.NumOfTestFailed,
+			Skipped:        tmplData.NumOfTestSkipped,
+			Incomplete:     tmplData.NumOfTestIncomplete,
+			Flaky:          tmplData.NumOfTestFlaky,
+			Packages:       len(tmplData.Packages),
+			PackagesFailed: tmplData.NumOfPackagesFailed,
+			Coverage:       newJSONReportCoverage(tmplData.Coverage),
+		},
+		Packages: []*jsonReportPackage{},
+		Tests:    []*jsonReportTest{},
+	}
+	for _, pkg := range tmplData.Packages {
+		var benchmarks []*jsonReportBenchmark
+		for _, benchmark := range pkg.Benchmarks {
+			metrics := map[string]float64{}
+			for _, metric := range benchmark.Metrics {
+				metrics[metric.Unit] = metric.Value
+			}
+			benchmarks = append(benchmarks, &jsonReportBenchmark{
+				Name:       benchmark.Name,
+				Procs:      benchmark.Procs,
+				Iterations: benchmark.Iterations,
+				Metrics:    metrics,
+				File:       benchmark.TestFileName,
+				Line:       benchmark.TestFunctionDetail.Line,
+				Col:        benchmark.TestFunctionDetail.Col,
+			})
+		}
+		report.Packages = append(report.Packages, &jsonReportPackage{
+			Name:         pkg.Name,
+			Status:       packageStatusName(pkg),
+			Elapsed:      pkg.ElapsedTime,
+			NoTestFiles:  pkg.NoTestFiles,
+			BuildFailed:  pkg.BuildFailed,
+			BuildOutput:  pkg.BuildOutput,
+			Output:       pkg.Output,
+			Sources:      pkg.Sources,
+			Benchmarks:   benchmarks,
+			Timeout:      pkg.Timeout,
+			RunningTests: pkg.RunningTests,
+			Coverage:     newJSONReportCoverage(pkg.Coverage),
+		})
+	}
+	// subtests are linked to their parent by generateReport
+	parentNames := map[*testStatus]string{}
+	for _, status := range allTests {
+		for _, subtest := range status.Subtests {
+			parentNames[subtest] = status.TestName
+		}
+	}
+	for _, status := range allTests {
+		test := &jsonReportTest{
+			Name:           status.TestName,
+			Package:        status.Package,
+			Parent:         parentNames[status],
+			Source:         status.Source,
+			Status:         testStatusName(status),
+			FailureSummary: status.FailureSummary,
+			Flaky:          status.Flaky,
+			TimedOut:       status.TimedOut,
+			Elapsed:        status.ElapsedTime,
+			Start:          status.StartTime,
+			End:            status.EndTime,
+			File:           status.TestFileName,
+			Line:           status.TestFunctionDetail.Line,
+			Col:            status.TestFunctionDetail.Col,
+			Output:         status.Output,
+			Fuzz:           status.Fuzz,
+		}
+		if status.FuzzFailure != nil {
+			test.FuzzFailure = &jsonReportFuzzFailure{
+				InputFile:    status.FuzzFailure.InputFile,
+				Input:        status.FuzzFailure.Input,
+				ReproCommand: status.FuzzFailure.ReproCommand,
+			}
+		}
+		// the attempts are only listed for tests that ran more than once, e.g. with "go test -count=N"
+		if len(status.Attempts) > 1 {
+			for _, attempt := range status.Attempts {
+				test.Attempts = append(test.Attempts, &jsonReportAttempt{
+					Status:  testStatusName(&testStatus{Passed: attempt.Passed, Skipped: attempt.Skipped, Incomplete: attempt.Incomplete}),
+					Elapsed: attempt.ElapsedTime,
+					Output:  attempt.Output,
+				})
+			}
+		}
+		report.Tests = append(report.Tests, test)
+	}
+	sort.Slice(report.Tests, func(i, j int) bool {
+		if report.Tests[i].Package != report.Tests[j].Package {
+			return report.Tests[i].Package < report.Tests[j].Package
+		}
+		return report.Tests[i].Name < report.Tests[j].Name
+	})
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func newJSONReportCoverage(coverage *packageCoverage) *jsonReportCoverage {
+	if coverage == nil {
+		return nil
+	}
+	return &jsonReportCoverage{
+		Percent:      coverage.Percent,
+		Statements:   coverage.Statements,
+		BelowMinimum: coverage.BelowMinimum,
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateJSONReport(t *testing.T) {
+	assertions := assert.New(t)
+	startTime := time.Date(2020, 7, 10, 6, 24, 44, 0, time.UTC)
+	endTime := startTime.Add(1250 * time.Millisecond)
+	tmplData := &templateData{
+		ReportTitle:        "test-title",
+		numOfTestsPerGroup: 20,
+	}
+	allTests := map[string]*testStatus{
+		"foo.TestFunc1": {
+			TestName:    "TestFunc1",
+			Package:     "foo",
+			ElapsedTime: 1.25,
+			Output:      []string{"=== RUN   TestFunc1\n"},
+			Passed:      true,
+			StartTime:   &startTime,
+			EndTime:     &endTime,
+		},
+		"foo.TestFunc1/case": {
+			TestName: "TestFunc1/case",
+			Package:  "foo",
+			Output:   []string{"=== RUN   TestFunc1/case\n"},
+			Skipped:  true,
+		},
+		"bar.TestFunc2": {
+			TestName: "TestFunc2",
+			Package:  "bar",
+			Output:   []string{"=== RUN   TestFunc2\n", "--- FAIL: TestFunc2 (0.00s)\n"},
+			// set by readFailureSummaries
+			FailureSummary: "foo_test.go:21: expected 1, got 2",
+		},
+		"bar.TestFunc3": {
+			TestName:    "TestFunc3",
+			Package:     "bar",
+			ElapsedTime: 0.75,
+			Output:      []string{"attempt 1\n", "attempt 2\n"},
+			Flaky:       true,
+			Attempts: []*testAttempt{
+				{ElapsedTime: 0.5, Output: []string{"attempt 1\n"}},
+				{ElapsedTime: 0.25, Output: []string{"attempt 2\n"}, Passed: true},
+			},
+		},
+	}
+	allPackages := map[string]*packageStatus{
+		"foo": {Name: "foo", Passed: true, ElapsedTime: 1.5, Output: []string{"ok  \tfoo\t1.500s\n"}, Benchmarks: []*benchmarkResult{
+			{Name: "BenchmarkFunc1", Package: "foo", Procs: 8, Iterations: 1000, Metrics: []*benchmarkMetric{{Value: 12.5, Unit: "ns/op"}}},
+		}},
+		"bar": {Name: "bar", Output: []string{"FAIL\tbar\t0.100s\n"}, Coverage: &packageCoverage{Percent: 40, Statements: 10}},
+	}
+	testFileDetailsByPackage := testFileDetailsByPackage{
+		"foo": {
+			"TestFunc1": &testFileDetail{
+				FileName:            "foo_test.go",
+				TestFunctionFilePos: testFunctionFilePos{Line: 10, Col: 1},
+			},
+		},
+	}
+	err := generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage, 3*time.Second, bufio.NewWriter(&bytes.Buffer{}))
+	assertions.Nil(err)
+
+	buffer := &bytes.Buffer{}
+	err = generateJSONReport(tmplData, allTests, buffer)
+	assertions.Nil(err)
+	report := &jsonReport{}
+	assertions.Nil(json.Unmarshal(buffer.Bytes(), report))
+	assertions.Equal(jsonReportVersion, report.Version)
+	assertions.Equal("test-title", report.Title)
+	assertions.Equal(3.0, report.Duration)
+	assertions.Equal(jsonReportTotals{
+		Tests:          4,
+		Passed:         1,
+		Failed:         2,
+		Skipped:        1,
+		Flaky:          1,
+		Packages:       2,
+		PackagesFailed: 1,
+		Coverage:       &jsonReportCoverage{Percent: 40, Statements: 10},
+	}, report.Totals)
+
+	assertions.Len(report.Packages, 2)
+	assertions.Equal("bar", report.Packages[0].Name)
+	assertions.Equal("fail", report.Packages[0].Status)
+	assertions.Equal(&jsonReportCoverage{Percent: 40, Statements: 10}, report.Packages[0].Coverage)
+	assertions.Nil(report.Packages[1].Coverage)
+	assertions.Equal("foo", report.Packages[1].Name)
+	assertions.Equal("pass", report.Packages[1].Status)
+	assertions.Equal(1.5, report.Packages[1].Elapsed)
+	assertions.Equal([]*jsonReportBenchmark{{
+		Name:       "BenchmarkFunc1",
+		Procs:      8,
+		Iterations: 1000,
+		Metrics:    map[string]float64{"ns/op": 12.5},
+	}}, report.Packages[1].Benchmarks)
+
+	assertions.Len(report.Tests, 4)
+	assertions.Equal(&jsonReportTest{
+		Name:           "TestFunc2",
+		Package:        "bar",
+		Status:         "fail",
+		FailureSummary: "foo_test.go:21: expected 1, got 2",
+		Output:         []string{"=== RUN   TestFunc2\n", "--- FAIL: TestFunc2 (0.00s)\n"},
+	}, report.Tests[0])
+	assertions.Equal(&jsonReportTest{
+		Name:    "TestFunc3",
+		Package: "bar",
+		Status:  "fail",
+		Flaky:   true,
+		Elapsed: 0.75,
+		Output:  []string{"attempt 1\n", "attempt 2\n"},
+		Attempts: []*jsonReportAttempt{
+			{Status: "fail", Elapsed: 0.5, Output: []string{"attempt 1\n"}},
+			{Status: "pass", Elapsed: 0.25, Output: []string{"attempt 2\n"}},
+		},
+	}, report.Tests[1])
+	assertions.Equal(&jsonReportTest{
+		Name:    "TestFunc1",
+		Package: "foo",
+		Status:  "pass",
+		Elapsed: 1.25,
+		Start:   &startTime,
+		End:     &endTime,
+		File:    "foo_test.go",
+		Line:    10,
+		Col:     1,
+		Output:  []string{"=== RUN   TestFunc1\n"},
+	}, report.Tests[2])
+	assertions.Equal("TestFunc1/case", report.Tests[3].Name)
+	assertions.Equal("TestFunc1", report.Tests[3].Parent)
+	assertions.Equal("skip", report.Tests[3].Status)
+	assertions.Equal("foo_test.go", report.Tests[3].File)
+}
+
+func TestGenerateJSONReportWithIncompleteAttempt(t *testing.T) {
+	assertions := assert.New(t)
+	// the test binary was killed while the test ran for the second time
+	data := `{"Action":"run","Package":"foo","Test":"TestFunc"}
+{"Action":"pass","Package":"foo","Test":"TestFunc","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestFunc"}
+{"Action":"output","Package":"foo","Test":"TestFunc","Output":"=== RUN   TestFunc\n"}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	assertions.Nil(generateReport(tmplData, allTests, nil, testFileDetailsByPackage{}, 0, bufio.NewWriter(&bytes.Buffer{})))
+
+	buffer := &bytes.Buffer{}
+	assertions.Nil(generateJSONReport(tmplData, allTests, buffer))
+	report := &jsonReport{}
+	assertions.Nil(json.Unmarshal(buffer.Bytes(), report))
+	assertions.Len(report.Tests, 1)
+	assertions.Equal("incomplete", report.Tests[0].Status)
+	assertions.Len(report.Tests[0].Attempts, 2)
+	assertions.Equal("pass", report.Tests[0].Attempts[0].Status)
+	assertions.Equal("incomplete", report.Tests[0].Attempts[1].Status)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+type (
+	junitTestSuites struct {
+		XMLName    xml.Name          `xml:"testsuites"`
+		Tests      int               `xml:"tests,attr"`
+		Failures   int               `xml:"failures,attr"`
+		Errors     int               `xml:"errors,attr"`
+		Skipped    int               `xml:"skipped,attr"`
+		Time       string            `xml:"time,attr"`
+		TestSuites []*junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name      string           `xml:"name,attr"`
+		Tests     int              `xml:"tests,attr"`
+		Failures  int              `xml:"failures,attr"`
+		Errors    int              `xml:"errors,attr"`
+		Skipped   int              `xml:"skipped,attr"`
+		Time      string           `xml:"time,attr"`
+		TestCases []*junitTestCase `xml:"testcase"`
+		SystemOut string           `xml:"system-out,omitempty"`
+	}
+
+	junitTestCase struct {
+		ClassName string        `xml:"classname,attr"`
+		Name      string        `xml:"name,attr"`
+		Time      string        `xml:"time,attr"`
+		File      string        `xml:"file,attr,omitempty"`
+		Line      int           `xml:"line,attr,omitempty"`
+		Failure   *junitMessage `xml:"failure,omitempty"`
+		Error     *junitMessage `xml:"error,omitempty"`
+		Skipped   *junitMessage `xml:"skipped,omitempty"`
+	}
+
+	junitMessage struct {
+		Message string `xml:"message,attr"`
+		Content string `xml:",chardata"`
+	}
+)
+
+// generateJUnitReport writes the tests as JUnit XML, using one testsuite per package. A package that failed
+// without a failing test (e.g. a build failure or a panic in TestMain) is reported as a testcase with an error,
+// since most CI systems would otherwise treat the package as successful.
+func generateJUnitReport(allTests map[string]*testStatus, allPackages map[string]*packageStatus, writer io.Writer) error {
+	testsByPackage := map[string][]*testStatus{}
+	for _, status := range allTests {
+		testsByPackage[status.Package] = append(testsByPackage[status.Package], status)
+	}
+	var packageNames []string
+	for packageName := range testsByPackage {
+		packageNames = append(packageNames, packageName)
+	}
+	for packageName := range allPackages {
+		if _, exists := testsByPackage[packageName]; !exists {
+			packageNames = append(packageNames, packageName)
+		}
+	}
+	sort.Strings(packageNames)
+
+	testSuites := &junitTestSuites{}
+	totalTime := 0.0
+	for _, packageName := range packageNames {
+		tests := testsByPackage[packageName]
+		sort.Slice(tests, func(i, j int) bool {
+			return tests[i].TestName < tests[j].TestName
+		})
+		testSuite := &junitTestSuite{Name: packageName}
+		for _, status := range tests {
+			testCase := &junitTestCase{
+				ClassName: packageName,
+				Name:      status.TestName,
+				Time:      junitTime(status.ElapsedTime),
+				File:      status.TestFileName,
+				Line:      status.TestFunctionDetail.Line,
+			}
+			if !status.Passed {
+				if status.Skipped {
+					testCase.Skipped = &junitMessage{Message: "Skipped", Content: strings.Join(status.Output, "")}
+					testSuite.Skipped++
+				} else if status.Incomplete {
+					// the outcome of the test is unknown, which is an error rather than a failure of the test
+					testCase.Error = &junitMessage{Message: "Incomplete", Content: strings.Join(status.Output, "")}
+					testSuite.Errors++
+				} else {
+					message := "Failed"
+					if status.FailureSummary != "" {
+						message = status.FailureSummary
+					} else if status.TimedOut {
+						message = "Timed out"
+					}
+					testCase.Failure = &junitMessage{Message: message, Content: strings.Join(status.Output, "")}
+					testSuite.Failures++
+				}
+			}
+			testSuite.TestCases = append(testSuite.TestCases, testCase)
+		}
+		elapsedTime := 0.0
+		if pkg, exists := allPackages[packageName]; exists {
+			elapsedTime = pkg.ElapsedTime
+			testSuite.SystemOut = strings.Join(pkg.Output, "")
+			if !pkg.Passed && !pkg.Skipped && testSuite.Failures == 0 {
+				testCase := &junitTestCase{ClassName: packageName, Name: "[package failed]", Time: junitTime(0)}
+				message := "package failed"
+				if pkg.BuildFailed {
+					testCase.Name = "[build failed]"
+					message = "build failed"
+				}
+				testCase.Error = &junitMessage{
+					Message: message,
+					Content: strings.Join(pkg.BuildOutput, "") + strings.Join(pkg.Output, ""),
+				}
+				testSuite.TestCases = append(testSuite.TestCases, testCase)
+				testSuite.Errors++
+			}
+		}
+		testSuite.Tests = len(testSuite.TestCases)
+		testSuite.Time = junitTime(elapsedTime)
+		totalTime += elapsedTime
+
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+		testSuites.Errors += testSuite.Errors
+		testSuites.Skipped += testSuite.Skipped
+		testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+	}
+	testSuites.Time = junitTime(totalTime)
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(testSuites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
+
+// junitTime formats elapsed seconds the way JUnit consumers expect them.
+func junitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
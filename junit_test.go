@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateJUnitReport(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"foo.TestFunc1": {
+			TestName:           "TestFunc1",
+			Package:            "foo",
+			ElapsedTime:        1.25,
+			Output:             []string{"=== RUN   TestFunc1\n", "--- PASS: TestFunc1 (1.25s)"},
+			Passed:             true,
+			TestFileName:       "foo_test.go",
+			TestFunctionDetail: testFunctionFilePos{Line: 10, Col: 1},
+		},
+		"foo.TestFunc2": {
+			TestName: "TestFunc2",
+			Package:  "foo",
+			Output:   []string{"=== RUN   TestFunc2\n", "    foo_test.go:21: expected 1, got 2\n", "--- FAIL: TestFunc2 (0.00s)\n"},
+			// the failure summary is the message of the failure
+			FailureSummary: "foo_test.go:21: expected 1, got 2",
+		},
+		"foo.TestFunc3": {
+			TestName: "TestFunc3",
+			Package:  "foo",
+			Output:   []string{"=== RUN   TestFunc3\n", "--- SKIP: TestFunc3 (0.00s)\n"},
+			Skipped:  true,
+		},
+		"foo.TestFunc4": {
+			TestName:   "TestFunc4",
+			Package:    "foo",
+			Output:     []string{"=== RUN   TestFunc4\n"},
+			Incomplete: true,
+		},
+	}
+	allPackages := map[string]*packageStatus{
+		"foo":    {Name: "foo", ElapsedTime: 1.5, Output: []string{"FAIL\n", "FAIL\tfoo\t1.500s\n"}},
+		"broken": {Name: "broken", BuildFailed: true, BuildOutput: []string{"./broken_test.go:7:2: undefined: foo\n"}},
+		"empty":  {Name: "empty", Skipped: true, NoTestFiles: true},
+	}
+	buffer := &bytes.Buffer{}
+	err := generateJUnitReport(allTests, allPackages, buffer)
+	assertions.Nil(err)
+	assertions.Contains(buffer.String(), xml.Header)
+
+	testSuites := &junitTestSuites{}
+	assertions.Nil(xml.Unmarshal(buffer.Bytes(), testSuites))
+	assertions.Equal(5, testSuites.Tests)
+	assertions.Equal(1, testSuites.Failures)
+	assertions.Equal(2, testSuites.Errors)
+	assertions.Equal(1, testSuites.Skipped)
+	assertions.Len(testSuites.TestSuites, 3)
+
+	broken := testSuites.TestSuites[0]
+	assertions.Equal("broken", broken.Name)
+	assertions.Len(broken.TestCases, 1)
+	assertions.Equal("[build failed]", broken.TestCases[0].Name)
+	assertions.Equal("./broken_test.go:7:2: undefined: foo\n", broken.TestCases[0].Error.Content)
+
+	empty := testSuites.TestSuites[1]
+	assertions.Equal("empty", empty.Name)
+	assertions.Empty(empty.TestCases)
+
+	foo := testSuites.TestSuites[2]
+	assertions.Equal("foo", foo.Name)
+	assertions.Equal("1.500", foo.Time)
+	assertions.Equal("FAIL\nFAIL\tfoo\t1.500s\n", foo.SystemOut)
+	assertions.Len(foo.TestCases, 4)
+	assertions.Equal("foo", foo.TestCases[0].ClassName)
+	assertions.Equal("TestFunc1", foo.TestCases[0].Name)
+	assertions.Equal("1.250", foo.TestCases[0].Time)
+	assertions.Equal("foo_test.go", foo.TestCases[0].File)
+	assertions.Equal(10, foo.TestCases[0].Line)
+	assertions.Nil(foo.TestCases[0].Failure)
+	assertions.Nil(foo.TestCases[0].Skipped)
+	assertions.Equal("foo_test.go:21: expected 1, got 2", foo.TestCases[1].Failure.Message)
+	assertions.Contains(foo.TestCases[1].Failure.Content, "expected 1, got 2")
+	assertions.Equal("Skipped", foo.TestCases[2].Skipped.Message)
+	assertions.Nil(foo.TestCases[3].Failure)
+	assertions.Equal("Incomplete", foo.TestCases[3].Error.Message)
+}
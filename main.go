@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -13,8 +14,10 @@ import (
 	"go/token"
 	"go/types"
 	"html/template"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,23 +29,90 @@ import (
 
 type (
 	goTestOutputRow struct {
-		Time     string
-		TestName string `json:"Test"`
-		Action   string
-		Package  string
-		Elapsed  float64
-		Output   string
+		Time        string
+		TestName    string `json:"Test"`
+		Action      string
+		Package     string
+		Elapsed     float64
+		Output      string
+		ImportPath  string
+		FailedBuild string
 	}
 
 	testStatus struct {
-		TestName           string
-		Package            string
-		ElapsedTime        float64
-		Output             []string
-		Passed             bool
-		Skipped            bool
-		TestFileName       string
-		TestFunctionDetail testFunctionFilePos
+		TestName                string
+		Package                 string
+		Source                  string
+		ElapsedTime             float64
+		Output                  []string
+		Passed                  bool
+		Skipped                 bool
+		Flaky                   bool
+		TimedOut                bool
+		Incomplete              bool
+		Attempts                []*testAttempt
+		Fuzz                    bool
+		FuzzFailure             *fuzzFailure
+		Panic                   *panicDetail
+		Races                   []string
+		FailureSummary          string
+		TestifyFailures         []*testifyFailure
+		TestFileName            string
+		TestFunctionDetail      testFunctionFilePos
+		Subtests                []*testStatus
+		NumOfSubtestsPassed     int
+		NumOfSubtestsFailed     int
+		NumOfSubtestsSkipped    int
+		NumOfSubtestsIncomplete int
+		// the times of the first and the last event of the test, nil if the events have no timestamps
+		StartTime *time.Time
+		EndTime   *time.Time
+		intervals []*testInterval
+	}
+
+	// testAttempt is a single run of a test, a test runs more than once with "go test -count=N" or when the
+	// output of a retried test run is read together with the output of the original run
+	testAttempt struct {
+		ElapsedTime float64
+		Output      []string
+		Passed      bool
+		Skipped     bool
+		// true if the attempt never passed, failed or was skipped and did not time out, set by summarizeAttempts
+		Incomplete bool
+		// set once the attempt passed, failed or was skipped
+		completed bool
+	}
+
+	packageStatus struct {
+		Name        string
+		ElapsedTime float64
+		Output      []string
+		Passed      bool
+		Skipped     bool
+		NoTestFiles bool
+		BuildFailed bool
+		BuildOutput []string
+		Sources     []string
+		Benchmarks  []*benchmarkResult
+		// the -timeout exceeded by the test binary, e.g. "10m0s", and the tests that were running at that time
+		Timeout             string
+		RunningTests        []string
+		Coverage            *packageCoverage
+		CoverageFiles       []*coverageFile
+		Timeline            *packageTimeline
+		failedBuild         string
+		completed           bool
+		readingRunningTests bool
+		startTime           *time.Time
+		endTime             *time.Time
+	}
+
+	// rawOutputLine is a line of the input that is not a "go test -json" event, e.g. "go: downloading ..."
+	rawOutputLine struct {
+		Source     string
+		LineNumber int
+		Offset     int64
+		Text       string
 	}
 
 	templateData struct {
@@ -52,19 +122,34 @@ type (
 		NumOfTestPassed                int
 		NumOfTestFailed                int
 		NumOfTestSkipped               int
+		NumOfTestFlaky                 int
+		NumOfTestIncomplete            int
 		NumOfTests                     int
+		Packages                       []*packageStatus
+		NumOfPackagesFailed            int
+		Coverage                       *packageCoverage
+		MinCoverage                    float64
+		NumOfPackagesBelowMinCoverage  int
+		CoverageFiles                  []*coverageFile
+		Races                          []*dataRace
+		RawOutput                      []*rawOutputLine
+		Benchmarks                     []*benchmarkResult
+		BenchmarkUnits                 []string
 		TestDuration                   time.Duration
 		ReportTitle                    string
 		JsCode                         template.JS
 		numOfTestsPerGroup             int
 		OutputFilename                 string
 		TestExecutionDate              string
+		TestCommand                    string
+		testStartTime                  time.Time
 	}
 
 	testGroupData struct {
-		FailureIndicator string
-		SkippedIndicator string
-		TestResults      []*testStatus
+		FailureIndicator    string
+		SkippedIndicator    string
+		IncompleteIndicator string
+		TestResults         []*testStatus
 	}
 
 	cmdFlags struct {
@@ -73,7 +158,18 @@ type (
 		groupSize  int
 		listFlag   string
 		outputFlag string
+		junitFlag  string
+		jsonFlag   string
+		mdFlag     string
+		failOnFlag []string
 		verbose    bool
+		strict     bool
+		// set by the run command to print the results of packages and failed tests while go test is running
+		progress bool
+		// the minimum coverage percentage of every package, 0 if not checked
+		minCoverage float64
+		// the coverage profile written by go test -coverprofile, not shown if empty
+		coverprofileFlag string
 	}
 
 	goListJSONModule struct {
@@ -83,12 +179,16 @@ type (
 	}
 
 	goListJSON struct {
-		Dir         string
-		ImportPath  string
-		Name        string
-		GoFiles     []string
-		TestGoFiles []string
-		Module      goListJSONModule
+		Dir        string
+		ImportPath string
+		Name       string
+		// the import path of the package tested by a package variant listed by "go list -test", e.g. "foo" for
+		// "foo [foo.test]" and "foo_test [foo.test]"
+		ForTest      string
+		GoFiles      []string
+		TestGoFiles  []string
+		XTestGoFiles []string
+		Module       goListJSONModule
 	}
 
 	testFunctionFilePos struct {
@@ -103,11 +203,26 @@ type (
 
 	testFileDetailsByTest    map[string]*testFileDetail
 	testFileDetailsByPackage map[string]testFileDetailsByTest
+	goListJSONByPackage      map[string]*goListJSON
+
+	// exitCodeError is returned by a command that needs go-test-report to exit with a specific status code.
+	exitCodeError struct {
+		code int
+		err  error
+	}
 )
 
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
 func main() {
 	rootCmd, _, _ := initRootCommand()
 	if err := rootCmd.Execute(); err != nil {
+		var exitErr *exitCodeError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
@@ -116,52 +231,37 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 	flags := &cmdFlags{}
 	tmplData := &templateData{}
 	rootCmd := &cobra.Command{
-		Use:  "go-test-report",
-		Long: "Captures go test output via stdin and parses it into a single self-contained html file.",
-		RunE: func(cmd *cobra.Command, args []string) (e error) {
+		Use: "go-test-report [flags] [files]",
+		Long: `Captures go test output via stdin and parses it into a single self-contained html file.
+
+If files are given, the go test output is read from the files instead of stdin and merged into a single
+report. File names may be glob patterns, and files ending in ".gz" are decompressed.`,
+		// the files are arguments of the root command, which must not be taken for the names of subcommands
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
 			startTime := time.Now()
-			if err := parseSizeFlag(tmplData, flags); err != nil {
+			if err := initTemplateData(tmplData, flags); err != nil {
 				return err
 			}
-			tmplData.numOfTestsPerGroup = flags.groupSize
-			tmplData.ReportTitle = flags.titleFlag
-			tmplData.OutputFilename = flags.outputFlag
-			if err := checkIfStdinIsPiped(); err != nil {
-				return err
-			}
-			stdin := os.Stdin
-			stdinScanner := bufio.NewScanner(stdin)
-			testReportHTMLTemplateFile, _ := os.Create(tmplData.OutputFilename)
-			reportFileWriter := bufio.NewWriter(testReportHTMLTemplateFile)
-			defer func() {
-				_ = stdin.Close()
-				if err := reportFileWriter.Flush(); err != nil {
-					e = err
-				}
-				if err := testReportHTMLTemplateFile.Close(); err != nil {
-					e = err
+			if len(args) > 0 {
+				if err := generateReports(cmd, tmplData, flags, nil, args, startTime); err != nil {
+					return err
 				}
-			}()
-			startTestTime := time.Now()
-			allPackageNames, allTests, err := readTestDataFromStdIn(stdinScanner, flags, cmd)
-			if err != nil {
-				return errors.New(err.Error() + "\n")
-			}
-			elapsedTestTime := time.Since(startTestTime)
-			// used to the location of test functions in test go files by package and test function name.
-			var testFileDetailByPackage testFileDetailsByPackage
-			if flags.listFlag != "" {
-				testFileDetailByPackage, err = getAllDetails(flags.listFlag)
 			} else {
-				testFileDetailByPackage, err = getPackageDetails(allPackageNames)
-			}
-			if err != nil {
-				return err
+				if err := checkIfStdinIsPiped(); err != nil {
+					return err
+				}
+				stdin := os.Stdin
+				defer func() {
+					_ = stdin.Close()
+				}()
+				if err := generateReports(cmd, tmplData, flags, stdin, nil, startTime); err != nil {
+					return err
+				}
 			}
-			err = generateReport(tmplData, allTests, testFileDetailByPackage, elapsedTestTime, reportFileWriter)
-			elapsedTime := time.Since(startTime)
-			elapsedTimeMsg := []byte(fmt.Sprintf("[go-test-report] finished in %s\n", elapsedTime))
-			if _, err := cmd.OutOrStdout().Write(elapsedTimeMsg); err != nil {
+			if err := checkFailOnPolicies(tmplData, flags.failOnFlag); err != nil {
+				// the report is complete, showing the usage would only hide the reason of the failure
+				cmd.SilenceUsage = true
 				return err
 			}
 			return nil
@@ -179,6 +279,7 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 		},
 	}
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(initRunCommand(tmplData, flags))
 	rootCmd.PersistentFlags().StringVarP(&flags.titleFlag,
 		"title",
 		"t",
@@ -204,6 +305,34 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 		"o",
 		"test_report.html",
 		"the HTML output file")
+	rootCmd.PersistentFlags().StringVar(&flags.junitFlag,
+		"junit",
+		"",
+		"the JUnit XML output file (not generated if empty)")
+	rootCmd.PersistentFlags().StringVar(&flags.jsonFlag,
+		"json-out",
+		"",
+		"the JSON summary output file (not generated if empty)")
+	rootCmd.PersistentFlags().StringVar(&flags.mdFlag,
+		"markdown",
+		"",
+		"the markdown summary output file (not generated if empty)")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.failOnFlag,
+		"fail-on",
+		[]string{},
+		"exit with a non-zero status after writing the report if any of the comma-separated conditions is met: "+strings.Join(failOnPolicies, ", "))
+	rootCmd.PersistentFlags().Float64Var(&flags.minCoverage,
+		"min-coverage",
+		0,
+		"mark the packages with a coverage (see go test -cover) below the given percentage")
+	rootCmd.PersistentFlags().StringVar(&flags.coverprofileFlag,
+		"coverprofile",
+		"",
+		"the coverage profile written by go test -coverprofile, shown as annotated source files in the report (not shown if empty)")
+	rootCmd.PersistentFlags().BoolVar(&flags.strict,
+		"strict",
+		false,
+		"fail if the input contains a line that is not a go test -json event, instead of adding it to the raw output section of the report")
 	rootCmd.PersistentFlags().BoolVarP(&flags.verbose,
 		"verbose",
 		"v",
@@ -213,92 +342,582 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 	return rootCmd, tmplData, flags
 }
 
-func readTestDataFromStdIn(stdinScanner *bufio.Scanner, flags *cmdFlags, cmd *cobra.Command) (allPackageNames map[string]*types.Nil, allTests map[string]*testStatus, e error) {
-	allTests = map[string]*testStatus{}
-	allPackageNames = map[string]*types.Nil{}
+// initTemplateData validates the flags shared by all commands and copies their values into the template data.
+func initTemplateData(tmplData *templateData, flags *cmdFlags) error {
+	if err := parseSizeFlag(tmplData, flags); err != nil {
+		return err
+	}
+	tmplData.numOfTestsPerGroup = flags.groupSize
+	tmplData.ReportTitle = flags.titleFlag
+	tmplData.OutputFilename = flags.outputFlag
+	if flags.minCoverage < 0 || flags.minCoverage > 100 {
+		return fmt.Errorf("invalid --min-coverage value %v; the value must be between 0 and 100", flags.minCoverage)
+	}
+	tmplData.MinCoverage = flags.minCoverage
+	return checkFailOnFlag(flags)
+}
 
-	// read from stdin and parse "go test" results
-	for stdinScanner.Scan() {
-		lineInput := stdinScanner.Bytes()
+// generateReports reads the "go test -json" output from the given files, or from stdin if no file is given, then
+// writes the HTML report as well as every additional report requested by the flags.
+func generateReports(cmd *cobra.Command, tmplData *templateData, flags *cmdFlags, stdin io.Reader, fileNames []string, startTime time.Time) (e error) {
+	testReportHTMLTemplateFile, _ := os.Create(tmplData.OutputFilename)
+	reportFileWriter := bufio.NewWriter(testReportHTMLTemplateFile)
+	defer func() {
+		if err := reportFileWriter.Flush(); err != nil {
+			e = err
+		}
+		if err := testReportHTMLTemplateFile.Close(); err != nil {
+			e = err
+		}
+	}()
+	startTestTime := time.Now()
+	var allPackageNames map[string]*types.Nil
+	var allTests map[string]*testStatus
+	var allPackages map[string]*packageStatus
+	var rawOutput []*rawOutputLine
+	var err error
+	if len(fileNames) > 0 {
+		allPackageNames, allTests, allPackages, rawOutput, err = readTestDataFromFiles(fileNames, flags, cmd)
+	} else {
+		allPackageNames, allTests, allPackages, rawOutput, err = readTestDataFromStdIn(stdin, flags, cmd)
+	}
+	if err != nil {
+		return errors.New(err.Error() + "\n")
+	}
+	if len(rawOutput) > 0 {
+		warningMsg := fmt.Sprintf("[go-test-report] WARNING: %d line(s) of the input are not go test -json events, see the raw output section of the report\n", len(rawOutput))
+		if _, err := io.WriteString(cmd.ErrOrStderr(), warningMsg); err != nil {
+			return err
+		}
+	}
+	tmplData.RawOutput = rawOutput
+	elapsedTestTime := time.Since(startTestTime)
+	// the events of go test are timestamped, which dates the test run even if its output was saved to a file and
+	// read later. The time spent reading the input is only used if the events have no timestamps.
+	if start, end := testRunTimes(allPackages); start != nil {
+		tmplData.testStartTime = *start
+		elapsedTestTime = end.Sub(*start)
+	}
+	// used to the location of test functions in test go files by package and test function name.
+	var testFileDetailByPackage testFileDetailsByPackage
+	var goListByPackage goListJSONByPackage
+	if flags.listFlag != "" {
+		testFileDetailByPackage, goListByPackage, err = getAllDetails(flags.listFlag)
+	} else {
+		testFileDetailByPackage, goListByPackage, err = getPackageDetails(goListPackageNames(allPackageNames, allPackages))
+	}
+	if err != nil {
+		return err
+	}
+	readFuzzFailures(allTests, goListByPackage)
+	readPanics(allTests, goListByPackage)
+	tmplData.Races = readRaces(allTests, goListByPackage)
+	readTestifyFailures(allTests)
+	readFailureSummaries(allTests)
+	readTimelines(allTests, allPackages)
+	readCoverageStatements(allPackages, goListByPackage)
+	if flags.coverprofileFlag != "" {
+		tmplData.CoverageFiles, err = readCoverageProfile(flags.coverprofileFlag, goListByPackage)
+		if err != nil {
+			return err
+		}
+		for _, file := range tmplData.CoverageFiles {
+			if pkg := allPackages[file.Package]; pkg != nil {
+				pkg.CoverageFiles = append(pkg.CoverageFiles, file)
+			}
+		}
+		readProfileStatements(allPackages, tmplData.CoverageFiles)
+	}
+	err = generateReport(tmplData, allTests, allPackages, testFileDetailByPackage, elapsedTestTime, reportFileWriter)
+	if err != nil {
+		return err
+	}
+	if flags.junitFlag != "" {
+		err = writeReportFile(flags.junitFlag, func(writer io.Writer) error {
+			return generateJUnitReport(allTests, allPackages, writer)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if flags.jsonFlag != "" {
+		err = writeReportFile(flags.jsonFlag, func(writer io.Writer) error {
+			return generateJSONReport(tmplData, allTests, writer)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if flags.mdFlag != "" {
+		err = writeReportFile(flags.mdFlag, func(writer io.Writer) error {
+			return generateMarkdownReport(tmplData, allTests, writer)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	elapsedTime := time.Since(startTime)
+	elapsedTimeMsg := []byte(fmt.Sprintf("[go-test-report] finished in %s\n", elapsedTime))
+	if _, err := cmd.OutOrStdout().Write(elapsedTimeMsg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readTestDataFromStdIn parses the "go test -json" events read from stdin, one event per line. Lines may be of any
+// length. Lines that are not valid JSON are returned as raw output, unless the strict flag is set, in which case
+// they are reported as an error along with their byte offset in the input.
+func readTestDataFromStdIn(stdin io.Reader, flags *cmdFlags, cmd *cobra.Command) (allPackageNames map[string]*types.Nil, allTests map[string]*testStatus, allPackages map[string]*packageStatus, rawOutput []*rawOutputLine, e error) {
+	testData := newTestDataReader(flags, cmd)
+	if err := testData.read(stdin, ""); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return testData.allPackageNames, testData.allTests, testData.allPackages, testData.rawOutput, nil
+}
+
+// readTestDataFromFiles parses and merges the "go test -json" events of several files, e.g. the output of tests
+// that are sharded across CI machines. File names may be glob patterns, and files ending in ".gz" are decompressed.
+// Every test and package is attributed to the file(s) it was read from.
+func readTestDataFromFiles(fileNames []string, flags *cmdFlags, cmd *cobra.Command) (allPackageNames map[string]*types.Nil, allTests map[string]*testStatus, allPackages map[string]*packageStatus, rawOutput []*rawOutputLine, e error) {
+	testData := newTestDataReader(flags, cmd)
+	for _, pattern := range fileNames {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if len(matches) == 0 {
+			// not a glob pattern (or a pattern without matches), report the file as missing
+			matches = []string{pattern}
+		}
+		for _, fileName := range matches {
+			if err := testData.readFile(fileName); err != nil {
+				return nil, nil, nil, nil, err
+			}
+		}
+	}
+	return testData.allPackageNames, testData.allTests, testData.allPackages, testData.rawOutput, nil
+}
+
+// testDataReader accumulates the "go test -json" events of one or more inputs into a single set of tests and
+// packages.
+type testDataReader struct {
+	flags           *cmdFlags
+	cmd             *cobra.Command
+	progress        *progressWriter
+	allPackageNames map[string]*types.Nil
+	allTests        map[string]*testStatus
+	allPackages     map[string]*packageStatus
+	rawOutput       []*rawOutputLine
+}
+
+func newTestDataReader(flags *cmdFlags, cmd *cobra.Command) *testDataReader {
+	testData := &testDataReader{
+		flags:           flags,
+		cmd:             cmd,
+		allPackageNames: map[string]*types.Nil{},
+		allTests:        map[string]*testStatus{},
+		allPackages:     map[string]*packageStatus{},
+	}
+	if flags.progress {
+		testData.progress = &progressWriter{writer: cmd.OutOrStdout(), testOutput: map[string][]string{}}
+	}
+	return testData
+}
+
+func (testData *testDataReader) readFile(fileName string) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var reader io.Reader = f
+	if strings.HasSuffix(fileName, ".gz") {
+		gzipReader, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fileName, err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	if err := testData.read(reader, fileName); err != nil {
+		return fmt.Errorf("%s: %s", fileName, err)
+	}
+	return nil
+}
+
+// read parses the events of a single input. The source is the name of the input file, or empty for stdin.
+func (testData *testDataReader) read(input io.Reader, source string) error {
+	flags := testData.flags
+	cmd := testData.cmd
+	// output of "build-output" actions by the import path of the package being built, e.g. "foo [foo.test]"
+	buildOutputByImportPath := map[string][]string{}
+	// packages that failed to build while reading this input
+	var failedBuilds []*packageStatus
+
+	inputReader := bufio.NewReader(input)
+	lineNumber := 0
+	var offset int64
+	for {
+		lineInput, err := inputReader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(lineInput) == 0 {
+			break
+		}
+		lineNumber++
+		lineOffset := offset
+		offset += int64(len(lineInput))
+		lineInput = bytes.TrimRight(lineInput, "\r\n")
 		if flags.verbose {
 			newline := []byte("\n")
 			if _, err := cmd.OutOrStdout().Write(append(lineInput, newline[0])); err != nil {
-				return nil, nil, err
+				return err
 			}
 		}
 		goTestOutputRow := &goTestOutputRow{}
 		if err := json.Unmarshal(lineInput, goTestOutputRow); err != nil {
-			return nil, nil, err
+			if flags.strict {
+				return fmt.Errorf("malformed go test -json event on line %d (byte offset %d): %s", lineNumber, lineOffset, err)
+			}
+			if len(bytes.TrimSpace(lineInput)) > 0 {
+				testData.rawOutput = append(testData.rawOutput, &rawOutputLine{
+					Source:     source,
+					LineNumber: lineNumber,
+					Offset:     lineOffset,
+					Text:       string(lineInput),
+				})
+			}
+			continue
 		}
-		if goTestOutputRow.TestName != "" {
-			var status *testStatus
-			key := goTestOutputRow.Package + "." + goTestOutputRow.TestName
-			if _, exists := allTests[key]; !exists {
-				status = &testStatus{
-					TestName: goTestOutputRow.TestName,
-					Package:  goTestOutputRow.Package,
-					Output:   []string{},
-				}
-				allTests[key] = status
-			} else {
-				status = allTests[key]
+		if testData.progress != nil {
+			if err := testData.progress.write(goTestOutputRow); err != nil {
+				return err
 			}
-			if goTestOutputRow.Action == "pass" || goTestOutputRow.Action == "fail" || goTestOutputRow.Action == "skip" {
-				if goTestOutputRow.Action == "pass" {
-					status.Passed = true
-				}
-				if goTestOutputRow.Action == "skip" {
-					status.Skipped = true
-				}
-				status.ElapsedTime = goTestOutputRow.Elapsed
+		}
+		if goTestOutputRow.Action == "build-output" || goTestOutputRow.Action == "build-fail" {
+			if goTestOutputRow.Output != "" {
+				buildOutputByImportPath[goTestOutputRow.ImportPath] = append(buildOutputByImportPath[goTestOutputRow.ImportPath], goTestOutputRow.Output)
+			}
+			continue
+		}
+		if goTestOutputRow.Package == "" {
+			continue
+		}
+		pkg, exists := testData.allPackages[goTestOutputRow.Package]
+		if !exists {
+			pkg = &packageStatus{
+				Name:   goTestOutputRow.Package,
+				Output: []string{},
+			}
+			testData.allPackages[goTestOutputRow.Package] = pkg
+		}
+		if source != "" && (len(pkg.Sources) == 0 || pkg.Sources[len(pkg.Sources)-1] != source) {
+			pkg.Sources = append(pkg.Sources, source)
+		}
+		eventTime := parseEventTime(goTestOutputRow.Time)
+		pkg.startTime = earliestTime(pkg.startTime, eventTime)
+		pkg.endTime = latestTime(pkg.endTime, eventTime)
+		var benchmark *benchmarkResult
+		if goTestOutputRow.Action == "output" {
+			readTimeoutOutput(pkg, goTestOutputRow.Output)
+			benchmark = parseBenchmarkLine(goTestOutputRow.Output, goTestOutputRow.TestName)
+			if benchmark != nil {
+				benchmark.Package = pkg.Name
+				pkg.Benchmarks = append(pkg.Benchmarks, benchmark)
 			}
-			allPackageNames[goTestOutputRow.Package] = nil
-			if strings.Contains(goTestOutputRow.Output, "--- PASS:") {
-				goTestOutputRow.Output = strings.TrimSpace(goTestOutputRow.Output)
+		}
+		if goTestOutputRow.TestName == "" {
+			readPackageEvent(pkg, goTestOutputRow)
+			if goTestOutputRow.FailedBuild != "" {
+				failedBuilds = append(failedBuilds, pkg)
+			}
+			continue
+		}
+		var status *testStatus
+		key := goTestOutputRow.Package + "." + goTestOutputRow.TestName
+		if _, exists := testData.allTests[key]; !exists {
+			status = &testStatus{
+				TestName: goTestOutputRow.TestName,
+				Package:  goTestOutputRow.Package,
+				Source:   source,
+				Output:   []string{},
+				Fuzz:     isFuzzTest(goTestOutputRow.TestName),
 			}
-			status.Output = append(status.Output, goTestOutputRow.Output)
+			testData.allTests[key] = status
+		} else {
+			status = testData.allTests[key]
+		}
+		// every "run" event starts a new attempt of the test
+		if goTestOutputRow.Action == "run" || len(status.Attempts) == 0 {
+			status.Attempts = append(status.Attempts, &testAttempt{Output: []string{}})
 		}
+		attempt := status.Attempts[len(status.Attempts)-1]
+		status.StartTime = earliestTime(status.StartTime, eventTime)
+		status.EndTime = latestTime(status.EndTime, eventTime)
+		readTestInterval(status, goTestOutputRow.Action, eventTime)
+		if goTestOutputRow.Action == "pass" || goTestOutputRow.Action == "fail" || goTestOutputRow.Action == "skip" {
+			attempt.Passed = goTestOutputRow.Action == "pass"
+			attempt.Skipped = goTestOutputRow.Action == "skip"
+			attempt.ElapsedTime = goTestOutputRow.Elapsed
+			attempt.completed = true
+			summarizeAttempts(status)
+		}
+		if benchmark != nil {
+			testData.completeBenchmark(status)
+		}
+		testData.allPackageNames[goTestOutputRow.Package] = nil
+		if strings.Contains(goTestOutputRow.Output, "--- PASS:") {
+			goTestOutputRow.Output = strings.TrimSpace(goTestOutputRow.Output)
+		}
+		status.Output = append(status.Output, goTestOutputRow.Output)
+		attempt.Output = append(attempt.Output, goTestOutputRow.Output)
+	}
+	// attach the compiler output to the packages that could not be built
+	for _, pkg := range failedBuilds {
+		pkg.BuildOutput = append(pkg.BuildOutput, buildOutputByImportPath[pkg.failedBuild]...)
+	}
+	testData.markTimedOutTests()
+	// the status of the tests that were started but never passed, failed or were skipped is only known now
+	for _, status := range testData.allTests {
+		summarizeAttempts(status)
+	}
+	return nil
+}
+
+// completeBenchmark marks the current attempt of a benchmark, and of the benchmarks it was started from, as
+// passed once the benchmark reported a result. go test does not report a "pass" event for benchmarks, a failing
+// benchmark still reports a "fail" event afterwards.
+func (testData *testDataReader) completeBenchmark(status *testStatus) {
+	name := status.TestName
+	for {
+		if benchmark, exists := testData.allTests[status.Package+"."+name]; exists && len(benchmark.Attempts) > 0 {
+			benchmark.Attempts[len(benchmark.Attempts)-1].Passed = true
+			benchmark.Attempts[len(benchmark.Attempts)-1].completed = true
+			summarizeAttempts(benchmark)
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return
+		}
+		name = name[:i]
 	}
-	return allPackageNames, allTests, nil
 }
 
-func getAllDetails(listFile string) (testFileDetailsByPackage, error) {
+// summarizeAttempts sets the status of a test from the results of its attempts. A test fails if any of its
+// attempts failed and is skipped only if all of its attempts were skipped. It is flaky if it both passed and failed.
+// A test that did not fail but has an attempt that never passed, failed or was skipped (e.g. because the test binary
+// was killed or the input was truncated) is incomplete. The elapsed time of the test is the total time of all
+// attempts.
+func summarizeAttempts(status *testStatus) {
+	passed, failed, incomplete := false, false, false
+	status.ElapsedTime = 0
+	for _, attempt := range status.Attempts {
+		status.ElapsedTime += attempt.ElapsedTime
+		attempt.Incomplete = !attempt.Passed && !attempt.Skipped && !attempt.completed && !status.TimedOut
+		if attempt.Passed {
+			passed = true
+		} else if attempt.Skipped {
+			continue
+		} else if attempt.completed || status.TimedOut {
+			failed = true
+		} else {
+			incomplete = true
+		}
+	}
+	status.Passed = passed && !failed && !incomplete
+	status.Skipped = !passed && !failed && !incomplete
+	status.Incomplete = incomplete && !failed
+	status.Flaky = passed && failed
+}
+
+// MarshalJSON encodes the test for the HTML report. The attempts of a test that ran once are omitted, the output of
+// its only attempt is the output of the test.
+func (status *testStatus) MarshalJSON() ([]byte, error) {
+	type encodedTestStatus testStatus
+	encoded := encodedTestStatus(*status)
+	if len(encoded.Attempts) <= 1 {
+		encoded.Attempts = nil
+	}
+	return json.Marshal(&encoded)
+}
+
+// parseEventTime returns the timestamp of a "go test -json" event, nil if the event has no (valid) timestamp, e.g.
+// when the events were written by a tool other than go test.
+func parseEventTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// earliestTime returns the earlier of two times, ignoring unknown (nil) times.
+func earliestTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
+
+// latestTime returns the later of two times, ignoring unknown (nil) times.
+func latestTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+// testRunTimes returns the times of the first and the last event of all packages, nil if the events have no
+// timestamps. Packages read from several inputs (e.g. CI shards) span the time from the start of the first to the
+// end of the last input.
+func testRunTimes(allPackages map[string]*packageStatus) (start *time.Time, end *time.Time) {
+	for _, pkg := range allPackages {
+		start = earliestTime(start, pkg.startTime)
+		end = latestTime(end, pkg.endTime)
+	}
+	return start, end
+}
+
+// readPackageEvent updates the status of a package using an event that is not associated with a test, such as
+// the "ok", "FAIL" or "? ... [no test files]" summary printed by "go test" for every package.
+func readPackageEvent(pkg *packageStatus, goTestOutputRow *goTestOutputRow) {
+	switch goTestOutputRow.Action {
+	case "output":
+		pkg.Output = append(pkg.Output, goTestOutputRow.Output)
+		readCoverageOutput(pkg, goTestOutputRow.Output)
+		if strings.Contains(goTestOutputRow.Output, "[no test files]") {
+			pkg.NoTestFiles = true
+		}
+		if strings.Contains(goTestOutputRow.Output, "[build failed]") || strings.Contains(goTestOutputRow.Output, "[setup failed]") {
+			pkg.BuildFailed = true
+		}
+	case "pass", "fail", "skip":
+		if !pkg.completed {
+			pkg.Passed = goTestOutputRow.Action == "pass"
+			pkg.Skipped = goTestOutputRow.Action == "skip"
+			pkg.ElapsedTime = goTestOutputRow.Elapsed
+		} else {
+			// the package was tested by more than one input (e.g. CI shards), it only passed if no input failed
+			failed := goTestOutputRow.Action == "fail" || (!pkg.Passed && !pkg.Skipped)
+			pkg.Passed = !failed && (pkg.Passed || goTestOutputRow.Action == "pass")
+			pkg.Skipped = !failed && !pkg.Passed
+			pkg.ElapsedTime += goTestOutputRow.Elapsed
+		}
+		pkg.completed = true
+		if goTestOutputRow.FailedBuild != "" {
+			pkg.BuildFailed = true
+			pkg.failedBuild = goTestOutputRow.FailedBuild
+		}
+	}
+}
+
+// getAllDetails reads the file details of the tests from the output of "go list -json" saved in the list file. The
+// "go list" output of every package is returned as well.
+func getAllDetails(listFile string) (testFileDetailsByPackage, goListJSONByPackage, error) {
 	testFileDetailByPackage := testFileDetailsByPackage{}
+	goListByPackage := goListJSONByPackage{}
 	f, err := os.Open(listFile)
 	defer f.Close()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	list := json.NewDecoder(f)
 	for list.More() {
-		goListJSON := goListJSON{}
-		if err := list.Decode(&goListJSON); err != nil {
-			return nil, err
+		goListJSON := &goListJSON{}
+		if err := list.Decode(goListJSON); err != nil {
+			return nil, nil, err
 		}
-		packageName := goListJSON.ImportPath
-		testFileDetailsByTest, err := getFileDetails(&goListJSON)
+		packageName := testedPackagePath(goListJSON)
+		testFileDetailsByTest, err := getFileDetails(goListJSON)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		// the variants of a package listed by "go list -test" contain tests of the same package
+		if testFileDetailByPackage[packageName] == nil {
+			testFileDetailByPackage[packageName] = testFileDetailsByTest
+		} else {
+			for testName, detail := range testFileDetailsByTest {
+				testFileDetailByPackage[packageName][testName] = detail
+			}
+		}
+		if existing := goListByPackage[packageName]; existing == nil || packageVariant(goListJSON) < packageVariant(existing) {
+			goListByPackage[packageName] = goListJSON
 		}
-		testFileDetailByPackage[packageName] = testFileDetailsByTest
 	}
-	return testFileDetailByPackage, nil
+	return testFileDetailByPackage, goListByPackage, nil
 }
 
-func getPackageDetails(allPackageNames map[string]*types.Nil) (testFileDetailsByPackage, error) {
-	var testFileDetailByPackage testFileDetailsByPackage
+// testedPackagePath returns the import path go test reports the tests of a listed package under. The variants of a
+// package listed by "go list -test", i.e. the package compiled with its tests ("foo [foo.test]") and its external
+// test package ("foo_test [foo.test]"), are reported as the tested package ("foo").
+func testedPackagePath(goListJSON *goListJSON) string {
+	if goListJSON.ForTest != "" {
+		return goListJSON.ForTest
+	}
+	if i := strings.Index(goListJSON.ImportPath, " ["); i >= 0 && strings.HasSuffix(goListJSON.ImportPath, ".test]") {
+		return strings.TrimSuffix(goListJSON.ImportPath[i+2:len(goListJSON.ImportPath)-1], ".test")
+	}
+	return goListJSON.ImportPath
+}
+
+// packageVariant returns 0 for a package, 1 for the package compiled with its tests and 2 for its external test
+// package. The go list output of the package is preferred to the output of its variants.
+func packageVariant(goListJSON *goListJSON) int {
+	switch {
+	case goListJSON.ImportPath == testedPackagePath(goListJSON):
+		return 0
+	case isExternalTestPackage(goListJSON):
+		return 2
+	}
+	return 1
+}
+
+// isExternalTestPackage reports whether a listed package is the variant of an external test package listed by
+// "go list -test", whose go files are the XTestGoFiles of the tested package.
+func isExternalTestPackage(goListJSON *goListJSON) bool {
+	return goListJSON.ImportPath != testedPackagePath(goListJSON) && strings.HasSuffix(goListJSON.Name, "_test")
+}
+
+// goListPackageNames returns the names of the packages "go list" runs for: the packages with tests, and the packages
+// with a coverage but without tests, e.g. the untested packages of "go test -cover ./...". The statements of every
+// package with a coverage are needed to weight the total coverage.
+func goListPackageNames(allPackageNames map[string]*types.Nil, allPackages map[string]*packageStatus) map[string]*types.Nil {
+	packageNames := make(map[string]*types.Nil, len(allPackageNames))
+	for packageName := range allPackageNames {
+		packageNames[packageName] = nil
+	}
+	for packageName, pkg := range allPackages {
+		if pkg.Coverage != nil {
+			packageNames[packageName] = nil
+		}
+	}
+	return packageNames
+}
+
+// getPackageDetails runs "go list -json" for every package concurrently, returning the file details of the tests
+// as well as the "go list" output of every package.
+func getPackageDetails(allPackageNames map[string]*types.Nil) (testFileDetailsByPackage, goListJSONByPackage, error) {
+	type packageDetails struct {
+		packageName           string
+		goListJSON            *goListJSON
+		testFileDetailsByTest testFileDetailsByTest
+	}
 	ctx := context.Background()
 	g, ctx := errgroup.WithContext(ctx)
-	details := make(chan testFileDetailsByPackage)
+	details := make(chan *packageDetails)
 	for packageName := range allPackageNames {
 		name := packageName
 		g.Go(func() error {
-			testFileDetailsByTest, err := getTestDetails(name)
+			goListJSON, testFileDetailsByTest, err := getTestDetails(name)
 			if err != nil {
 				return err
 			}
 			select {
-			case details <- testFileDetailsByPackage{name: testFileDetailsByTest}:
+			case details <- &packageDetails{packageName: name, goListJSON: goListJSON, testFileDetailsByTest: testFileDetailsByTest}:
 			case <-ctx.Done():
 				return ctx.Err()
 			}
@@ -311,39 +930,55 @@ func getPackageDetails(allPackageNames map[string]*types.Nil) (testFileDetailsBy
 		close(details)
 	}()
 
-	testFileDetailByPackage = make(testFileDetailsByPackage, len(allPackageNames))
+	testFileDetailByPackage := make(testFileDetailsByPackage, len(allPackageNames))
+	goListByPackage := make(goListJSONByPackage, len(allPackageNames))
 	for d := range details {
-		for packageName, testFileDetailsByTest := range d {
-			testFileDetailByPackage[packageName] = testFileDetailsByTest
-		}
+		testFileDetailByPackage[d.packageName] = d.testFileDetailsByTest
+		goListByPackage[d.packageName] = d.goListJSON
 	}
 	if err := g.Wait(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return testFileDetailByPackage, nil
+	return testFileDetailByPackage, goListByPackage, nil
 }
 
-func getTestDetails(packageName string) (testFileDetailsByTest, error) {
+func getTestDetails(packageName string) (*goListJSON, testFileDetailsByTest, error) {
+	goListJSON, err := goListPackage(packageName)
+	if err != nil {
+		return nil, nil, err
+	}
+	testFileDetailsByTest, err := getFileDetails(goListJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	return goListJSON, testFileDetailsByTest, nil
+}
+
+// goListPackage returns the output of "go list -json" for a package.
+func goListPackage(packageName string) (*goListJSON, error) {
 	var out bytes.Buffer
-	var cmd *exec.Cmd
-	stringReader := strings.NewReader("")
-	cmd = exec.Command("go", "list", "-json", packageName)
-	cmd.Stdin = stringReader
+	cmd := exec.Command("go", "list", "-json", packageName)
+	cmd.Stdin = strings.NewReader("")
 	cmd.Stdout = &out
-	err := cmd.Run()
-	if err != nil {
+	if err := cmd.Run(); err != nil {
 		return nil, err
 	}
 	goListJSON := &goListJSON{}
 	if err := json.Unmarshal(out.Bytes(), goListJSON); err != nil {
 		return nil, err
 	}
-	return getFileDetails(goListJSON)
+	return goListJSON, nil
 }
 
+// getFileDetails returns the location of the functions declared in the test files of a package, including the files
+// of its external test package (package foo_test).
 func getFileDetails(goListJSON *goListJSON) (testFileDetailsByTest, error) {
 	testFileDetailByTest := map[string]*testFileDetail{}
-	for _, file := range goListJSON.TestGoFiles {
+	testFiles := append(append([]string{}, goListJSON.TestGoFiles...), goListJSON.XTestGoFiles...)
+	if isExternalTestPackage(goListJSON) {
+		testFiles = append(testFiles, goListJSON.GoFiles...)
+	}
+	for _, file := range testFiles {
 		sourceFilePath := fmt.Sprintf("%s/%s", goListJSON.Dir, file)
 		fileSet := token.NewFileSet()
 		f, err := parser.ParseFile(fileSet, sourceFilePath, nil, 0)
@@ -389,7 +1024,99 @@ func (t byName) Less(i, j int) bool {
 	return t[i].name < t[j].name
 }
 
-func generateReport(tmplData *templateData, allTests map[string]*testStatus, testFileDetailByPackage testFileDetailsByPackage, elapsedTestTime time.Duration, reportFileWriter *bufio.Writer) error {
+// buildTestTree links every subtest to its parent using the slash-separated names reported by "go test" (e.g.
+// "TestParse/empty_input" is a subtest of "TestParse") and returns the top level tests sorted by name. Subtests
+// whose parent is not part of allTests are treated as top level tests.
+func buildTestTree(allTests map[string]*testStatus) []*testStatus {
+	// sort the allTests map by test name (this will produce a consistent order when iterating through the map)
+	var tests []testRef
+	testsByName := make(map[string]*testStatus, len(allTests))
+	for test, status := range allTests {
+		tests = append(tests, testRef{test, status.TestName})
+		testsByName[status.Package+"."+status.TestName] = status
+		status.Subtests = nil
+	}
+	sort.Sort(byName(tests))
+	var rootTests []*testStatus
+	for _, test := range tests {
+		status := allTests[test.key]
+		if parent := findParentTest(status, testsByName); parent != nil {
+			parent.Subtests = append(parent.Subtests, status)
+		} else {
+			rootTests = append(rootTests, status)
+		}
+	}
+	for _, status := range rootTests {
+		summarizeSubtests(status)
+	}
+	return rootTests
+}
+
+// findParentTest returns the closest ancestor of the given test. Since subtest names may contain slashes
+// themselves, every slash-separated prefix of the name is tried, starting with the longest one.
+func findParentTest(status *testStatus, testsByName map[string]*testStatus) *testStatus {
+	name := status.TestName
+	for i := strings.LastIndex(name, "/"); i > 0; i = strings.LastIndex(name, "/") {
+		name = name[:i]
+		if parent, exists := testsByName[status.Package+"."+name]; exists {
+			return parent
+		}
+	}
+	return nil
+}
+
+// summarizeSubtests counts the passed, failed, skipped and incomplete subtests (at any depth) of the given test.
+func summarizeSubtests(status *testStatus) (passed int, failed int, skipped int, incomplete int) {
+	status.NumOfSubtestsPassed = 0
+	status.NumOfSubtestsFailed = 0
+	status.NumOfSubtestsSkipped = 0
+	status.NumOfSubtestsIncomplete = 0
+	for _, subtest := range status.Subtests {
+		p, f, s, i := summarizeSubtests(subtest)
+		status.NumOfSubtestsPassed += p
+		status.NumOfSubtestsFailed += f
+		status.NumOfSubtestsSkipped += s
+		status.NumOfSubtestsIncomplete += i
+		if subtest.Passed {
+			status.NumOfSubtestsPassed++
+		} else if subtest.Skipped {
+			status.NumOfSubtestsSkipped++
+		} else if subtest.Incomplete {
+			status.NumOfSubtestsIncomplete++
+		} else {
+			status.NumOfSubtestsFailed++
+		}
+	}
+	return status.NumOfSubtestsPassed, status.NumOfSubtestsFailed, status.NumOfSubtestsSkipped, status.NumOfSubtestsIncomplete
+}
+
+// testStatusName returns the status of a test using the action names of "go test -json": pass, fail or skip, or
+// incomplete for a test that never reported any of those actions.
+func testStatusName(status *testStatus) string {
+	if status.Passed {
+		return "pass"
+	}
+	if status.Skipped {
+		return "skip"
+	}
+	if status.Incomplete {
+		return "incomplete"
+	}
+	return "fail"
+}
+
+// packageStatusName returns the status of a package using the action names of "go test -json": pass, fail or skip.
+func packageStatusName(pkg *packageStatus) string {
+	if pkg.Passed {
+		return "pass"
+	}
+	if pkg.Skipped {
+		return "skip"
+	}
+	return "fail"
+}
+
+func generateReport(tmplData *templateData, allTests map[string]*testStatus, allPackages map[string]*packageStatus, testFileDetailByPackage testFileDetailsByPackage, elapsedTestTime time.Duration, reportFileWriter *bufio.Writer) error {
 	// read the html template from the generated embedded asset go file
 	tpl := template.New("test_report.html.template")
 	testReportHTMLTemplateStr, err := hex.DecodeString(testReportHTMLTemplate)
@@ -409,38 +1136,49 @@ func generateReport(tmplData *templateData, allTests map[string]*testStatus, tes
 	tmplData.NumOfTestPassed = 0
 	tmplData.NumOfTestFailed = 0
 	tmplData.NumOfTestSkipped = 0
+	tmplData.NumOfTestFlaky = 0
+	tmplData.NumOfTestIncomplete = 0
 	tmplData.JsCode = template.JS(testReportJsCodeStr)
 	tgCounter := 0
 	tgID := 0
 
-	// sort the allTests map by test name (this will produce a consistent order when iterating through the map)
-	var tests []testRef
-	for test, status := range allTests {
-		tests = append(tests, testRef{test, status.TestName})
-	}
-	sort.Sort(byName(tests))
-	for _, test := range tests {
-		status := allTests[test.key]
-		if len(tmplData.TestResults) == tgID {
-			tmplData.TestResults = append(tmplData.TestResults, &testGroupData{})
-		}
-		// add file info(name and position; line and col) associated with the test function
-		testFileInfo := testFileDetailByPackage[status.Package][status.TestName]
+	// count every test, including subtests, and add the file info (name and position; line and col) associated
+	// with the test function. Subtests share the file info of the top level test function they were started from.
+	for _, status := range allTests {
+		testFunctionName := strings.SplitN(status.TestName, "/", 2)[0]
+		testFileInfo := testFileDetailByPackage[status.Package][testFunctionName]
 		if testFileInfo != nil {
 			status.TestFileName = testFileInfo.FileName
 			status.TestFunctionDetail = testFileInfo.TestFunctionFilePos
 		}
-		tmplData.TestResults[tgID].TestResults = append(tmplData.TestResults[tgID].TestResults, status)
-		if !status.Passed {
-			if !status.Skipped {
-				tmplData.TestResults[tgID].FailureIndicator = "failed"
-				tmplData.NumOfTestFailed++
-			} else {
-				tmplData.TestResults[tgID].SkippedIndicator = "skipped"
-				tmplData.NumOfTestSkipped++
-			}
-		} else {
+		if status.Passed {
 			tmplData.NumOfTestPassed++
+		} else if status.Skipped {
+			tmplData.NumOfTestSkipped++
+		} else if status.Incomplete {
+			tmplData.NumOfTestIncomplete++
+		} else {
+			tmplData.NumOfTestFailed++
+		}
+		if status.Flaky {
+			tmplData.NumOfTestFlaky++
+		}
+	}
+
+	// only top level tests are placed into test groups, subtests are reachable through their parent
+	for _, status := range buildTestTree(allTests) {
+		if len(tmplData.TestResults) == tgID {
+			tmplData.TestResults = append(tmplData.TestResults, &testGroupData{})
+		}
+		tmplData.TestResults[tgID].TestResults = append(tmplData.TestResults[tgID].TestResults, status)
+		if status.Skipped || status.NumOfSubtestsSkipped > 0 {
+			tmplData.TestResults[tgID].SkippedIndicator = "skipped"
+		}
+		if status.Incomplete || status.NumOfSubtestsIncomplete > 0 {
+			tmplData.TestResults[tgID].IncompleteIndicator = "incomplete"
+		}
+		if (!status.Passed && !status.Skipped && !status.Incomplete) || status.NumOfSubtestsFailed > 0 {
+			tmplData.TestResults[tgID].FailureIndicator = "failed"
 		}
 		tgCounter++
 		if tgCounter == tmplData.numOfTestsPerGroup {
@@ -448,9 +1186,47 @@ func generateReport(tmplData *templateData, allTests map[string]*testStatus, tes
 			tgID++
 		}
 	}
-	tmplData.NumOfTests = tmplData.NumOfTestPassed + tmplData.NumOfTestFailed + tmplData.NumOfTestSkipped
+	tmplData.NumOfTests = tmplData.NumOfTestPassed + tmplData.NumOfTestFailed + tmplData.NumOfTestSkipped + tmplData.NumOfTestIncomplete
+
+	// sort the packages by name, a package that neither passed nor was skipped failed (e.g. a build failure or a
+	// panic in TestMain) even if all of its tests passed
+	tmplData.Packages = make([]*packageStatus, 0, len(allPackages))
+	tmplData.NumOfPackagesFailed = 0
+	for _, pkg := range allPackages {
+		tmplData.Packages = append(tmplData.Packages, pkg)
+		if !pkg.Passed && !pkg.Skipped {
+			tmplData.NumOfPackagesFailed++
+		}
+	}
+	sort.Slice(tmplData.Packages, func(i, j int) bool {
+		return tmplData.Packages[i].Name < tmplData.Packages[j].Name
+	})
+	tmplData.Coverage = totalCoverage(tmplData.Packages, tmplData.MinCoverage)
+	tmplData.NumOfPackagesBelowMinCoverage = 0
+	for _, pkg := range tmplData.Packages {
+		if pkg.Coverage != nil && pkg.Coverage.BelowMinimum {
+			tmplData.NumOfPackagesBelowMinCoverage++
+		}
+	}
+
+	// benchmarks share the file info of the top level benchmark function, just like subtests
+	tmplData.Benchmarks = nil
+	for _, pkg := range tmplData.Packages {
+		for _, benchmark := range pkg.Benchmarks {
+			benchmarkFunctionName := strings.SplitN(benchmark.Name, "/", 2)[0]
+			if testFileInfo := testFileDetailByPackage[pkg.Name][benchmarkFunctionName]; testFileInfo != nil {
+				benchmark.TestFileName = testFileInfo.FileName
+				benchmark.TestFunctionDetail = testFileInfo.TestFunctionFilePos
+			}
+			tmplData.Benchmarks = append(tmplData.Benchmarks, benchmark)
+		}
+	}
+	tmplData.BenchmarkUnits = benchmarkUnits(tmplData.Benchmarks)
 	tmplData.TestDuration = elapsedTestTime.Round(time.Millisecond)
 	td := time.Now()
+	if !tmplData.testStartTime.IsZero() {
+		td = tmplData.testStartTime
+	}
 	tmplData.TestExecutionDate = fmt.Sprintf("%s %d, %d %02d:%02d:%02d",
 		td.Month(), td.Day(), td.Year(), td.Hour(), td.Minute(), td.Second())
 	if err := tpl.Execute(reportFileWriter, tmplData); err != nil {
@@ -459,6 +1235,94 @@ func generateReport(tmplData *templateData, allTests map[string]*testStatus, tes
 	return nil
 }
 
+// writeReportFile creates (or truncates) the named file and writes an additional report format into it.
+func writeReportFile(fileName string, write func(writer io.Writer) error) (e error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	fileWriter := bufio.NewWriter(file)
+	defer func() {
+		if err := fileWriter.Flush(); err != nil && e == nil {
+			e = err
+		}
+		if err := file.Close(); err != nil && e == nil {
+			e = err
+		}
+	}()
+	return write(fileWriter)
+}
+
+// failOnPolicies are the conditions accepted by the --fail-on flag:
+//   - failures: at least one test failed, or a package failed for a reason other than a build error
+//   - skips: at least one test was skipped
+//   - build-errors: at least one package failed to build
+//   - empty: the input did not contain any test
+//   - incomplete: at least one test never passed, failed or was skipped
+//   - coverage: the coverage of at least one package is below --min-coverage
+var failOnPolicies = []string{"failures", "skips", "build-errors", "empty", "incomplete", "coverage"}
+
+func checkFailOnFlag(flags *cmdFlags) error {
+	for _, policy := range flags.failOnFlag {
+		valid := false
+		for _, failOnPolicy := range failOnPolicies {
+			if policy == failOnPolicy {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf(`invalid --fail-on value "%s"; valid values are: %s`, policy, strings.Join(failOnPolicies, ", "))
+		}
+	}
+	return nil
+}
+
+// checkFailOnPolicies returns an error if the test results computed by generateReport meet any of the given
+// --fail-on conditions.
+func checkFailOnPolicies(tmplData *templateData, failOn []string) error {
+	numOfBuildErrors := 0
+	numOfPackagesFailed := 0
+	for _, pkg := range tmplData.Packages {
+		if pkg.BuildFailed {
+			numOfBuildErrors++
+		} else if !pkg.Passed && !pkg.Skipped {
+			numOfPackagesFailed++
+		}
+	}
+	for _, policy := range failOn {
+		switch policy {
+		case "failures":
+			if tmplData.NumOfTestFailed > 0 {
+				return fmt.Errorf("ERROR: %d test(s) failed", tmplData.NumOfTestFailed)
+			}
+			if numOfPackagesFailed > 0 {
+				return fmt.Errorf("ERROR: %d package(s) failed", numOfPackagesFailed)
+			}
+		case "incomplete":
+			if tmplData.NumOfTestIncomplete > 0 {
+				return fmt.Errorf("ERROR: %d test(s) incomplete", tmplData.NumOfTestIncomplete)
+			}
+		case "skips":
+			if tmplData.NumOfTestSkipped > 0 {
+				return fmt.Errorf("ERROR: %d test(s) skipped", tmplData.NumOfTestSkipped)
+			}
+		case "build-errors":
+			if numOfBuildErrors > 0 {
+				return fmt.Errorf("ERROR: %d package(s) failed to build", numOfBuildErrors)
+			}
+		case "empty":
+			if tmplData.NumOfTests == 0 {
+				return errors.New("ERROR: no tests found in the input")
+			}
+		case "coverage":
+			if tmplData.NumOfPackagesBelowMinCoverage > 0 {
+				return fmt.Errorf("ERROR: the coverage of %d package(s) is below %v%%", tmplData.NumOfPackagesBelowMinCoverage, tmplData.MinCoverage)
+			}
+		}
+	}
+	return nil
+}
+
 func parseSizeFlag(tmplData *templateData, flags *cmdFlags) error {
 	flags.sizeFlag = strings.ToLower(flags.sizeFlag)
 	if !strings.Contains(flags.sizeFlag, "x") {
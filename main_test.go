@@ -3,9 +3,12 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -145,6 +148,65 @@ func TestGroupOutputFlagIfMissingValue(t *testing.T) {
 	assertions.Equal(rootCmdErr.Error(), `flag needs an argument: --output`)
 }
 
+func TestFailOnFlagIfInvalidValue(t *testing.T) {
+	assertions := assert.New(t)
+	buffer := bytes.NewBufferString("")
+	rootCmd, _, flags := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"--fail-on", "failures,timeouts"})
+	rootCmdErr := rootCmd.Execute()
+	assertions.Error(rootCmdErr)
+	assertions.Equal([]string{"failures", "timeouts"}, flags.failOnFlag)
+	assertions.Equal(`invalid --fail-on value "timeouts"; valid values are: failures, skips, build-errors, empty, incomplete, coverage`, rootCmdErr.Error())
+}
+
+func TestMinCoverageFlagIfInvalidValue(t *testing.T) {
+	assertions := assert.New(t)
+	buffer := bytes.NewBufferString("")
+	rootCmd, _, flags := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"--min-coverage", "120"})
+	rootCmdErr := rootCmd.Execute()
+	assertions.Error(rootCmdErr)
+	assertions.Equal(120.0, flags.minCoverage)
+	assertions.Equal(`invalid --min-coverage value 120; the value must be between 0 and 100`, rootCmdErr.Error())
+}
+
+func TestCheckFailOnPolicies(t *testing.T) {
+	assertions := assert.New(t)
+	passed := &templateData{
+		NumOfTests:      2,
+		NumOfTestPassed: 2,
+		Packages:        []*packageStatus{{Name: "foo", Passed: true}, {Name: "empty", Skipped: true, NoTestFiles: true}},
+	}
+	assertions.Nil(checkFailOnPolicies(passed, failOnPolicies))
+
+	failed := &templateData{NumOfTests: 2, NumOfTestPassed: 1, NumOfTestFailed: 1}
+	assertions.Nil(checkFailOnPolicies(failed, nil))
+	assertions.Nil(checkFailOnPolicies(failed, []string{"skips", "build-errors", "empty"}))
+	assertions.EqualError(checkFailOnPolicies(failed, []string{"failures"}), "ERROR: 1 test(s) failed")
+
+	packageFailed := &templateData{NumOfTests: 1, NumOfTestPassed: 1, Packages: []*packageStatus{{Name: "foo"}}}
+	assertions.EqualError(checkFailOnPolicies(packageFailed, []string{"failures"}), "ERROR: 1 package(s) failed")
+	assertions.Nil(checkFailOnPolicies(packageFailed, []string{"build-errors"}))
+
+	buildFailed := &templateData{Packages: []*packageStatus{{Name: "foo", BuildFailed: true}}}
+	assertions.Nil(checkFailOnPolicies(buildFailed, []string{"failures"}))
+	assertions.EqualError(checkFailOnPolicies(buildFailed, []string{"build-errors"}), "ERROR: 1 package(s) failed to build")
+	assertions.EqualError(checkFailOnPolicies(buildFailed, []string{"empty"}), "ERROR: no tests found in the input")
+
+	skipped := &templateData{NumOfTests: 1, NumOfTestSkipped: 1}
+	assertions.EqualError(checkFailOnPolicies(skipped, []string{"skips"}), "ERROR: 1 test(s) skipped")
+
+	incomplete := &templateData{NumOfTests: 2, NumOfTestPassed: 1, NumOfTestIncomplete: 1}
+	assertions.Nil(checkFailOnPolicies(incomplete, []string{"failures"}))
+	assertions.EqualError(checkFailOnPolicies(incomplete, []string{"incomplete"}), "ERROR: 1 test(s) incomplete")
+
+	uncovered := &templateData{NumOfTests: 1, NumOfTestPassed: 1, MinCoverage: 80, NumOfPackagesBelowMinCoverage: 2}
+	assertions.Nil(checkFailOnPolicies(uncovered, []string{"failures"}))
+	assertions.EqualError(checkFailOnPolicies(uncovered, []string{"coverage"}), "ERROR: the coverage of 2 package(s) is below 80%")
+}
+
 func TestReadTestDataFromStdIn(t *testing.T) {
 	assertions := assert.New(t)
 	flags := &cmdFlags{}
@@ -162,9 +224,8 @@ func TestReadTestDataFromStdIn(t *testing.T) {
 {"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc3","Output":"--- FAIL: TestFunc3 (0.00s)\n"}
 {"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"go-test-report","Test":"TestFunc3","Elapsed":0}
 `
-	stdinScanner := bufio.NewScanner(strings.NewReader(data))
 	cmd := &cobra.Command{}
-	allPackageNames, allTests, err := readTestDataFromStdIn(stdinScanner, flags, cmd)
+	allPackageNames, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), flags, cmd)
 	assertions.Nil(err)
 	assertions.Len(allPackageNames, 2)
 	assertions.Contains(allPackageNames, "go-test-report")
@@ -235,11 +296,98 @@ func TestGetAllDetails(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	testFileDetailsByPackage, err := getAllDetails(tmpfile.Name())
+	testFileDetailsByPackage, goListByPackage, err := getAllDetails(tmpfile.Name())
 	if err != nil {
 		t.Fatal(err)
 	}
 	assertions.Len(testFileDetailsByPackage, 1)
+	assertions.Len(goListByPackage, 1)
+}
+
+// writeTestPackage writes a package with an internal and an external test file to a temporary directory
+func writeTestPackage(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "xt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"xt.go":            "package xt\n\nfunc Add(a, b int) int { return a + b }\n",
+		"internal_test.go": "package xt\n\nimport \"testing\"\n\nfunc TestInternal(t *testing.T) {}\n",
+		"external_test.go": "package xt_test\n\nimport \"testing\"\n\nfunc TestExternal(t *testing.T) {}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGetFileDetailsWithExternalTests(t *testing.T) {
+	assertions := assert.New(t)
+	dir := writeTestPackage(t)
+	defer os.RemoveAll(dir)
+
+	testFileDetailsByTest, err := getFileDetails(&goListJSON{
+		Dir:          dir,
+		ImportPath:   "example.com/xt",
+		Name:         "xt",
+		GoFiles:      []string{"xt.go"},
+		TestGoFiles:  []string{"internal_test.go"},
+		XTestGoFiles: []string{"external_test.go"},
+	})
+	assertions.Nil(err)
+	assertions.Equal(&testFileDetail{FileName: "internal_test.go", TestFunctionFilePos: testFunctionFilePos{Line: 5, Col: 1}}, testFileDetailsByTest["TestInternal"])
+	assertions.Equal(&testFileDetail{FileName: "external_test.go", TestFunctionFilePos: testFunctionFilePos{Line: 5, Col: 1}}, testFileDetailsByTest["TestExternal"])
+	assertions.Nil(testFileDetailsByTest["Add"])
+}
+
+func TestGetAllDetailsWithTestVariants(t *testing.T) {
+	assertions := assert.New(t)
+	dir := writeTestPackage(t)
+	defer os.RemoveAll(dir)
+
+	// the output of "go list -test -json", listing the package, its test binary and the variants of the package
+	// compiled for its tests
+	data := fmt.Sprintf(`{"Dir": %[1]q, "ImportPath": "example.com/xt", "Name": "xt", "GoFiles": ["xt.go"], "TestGoFiles": ["internal_test.go"], "XTestGoFiles": ["external_test.go"]}
+{"Dir": %[1]q, "ImportPath": "example.com/xt.test", "Name": "main"}
+{"Dir": %[1]q, "ImportPath": "example.com/xt [example.com/xt.test]", "Name": "xt", "ForTest": "example.com/xt", "GoFiles": ["xt.go", "internal_test.go"], "TestGoFiles": ["internal_test.go"], "XTestGoFiles": ["external_test.go"]}
+{"Dir": %[1]q, "ImportPath": "example.com/xt_test [example.com/xt.test]", "Name": "xt_test", "ForTest": "example.com/xt", "GoFiles": ["external_test.go"]}
+`, dir)
+	listFile := filepath.Join(dir, "list.json")
+	if err := ioutil.WriteFile(listFile, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testFileDetailsByPackage, goListByPackage, err := getAllDetails(listFile)
+	assertions.Nil(err)
+	assertions.Len(testFileDetailsByPackage["example.com/xt"], 2)
+	assertions.Equal("internal_test.go", testFileDetailsByPackage["example.com/xt"]["TestInternal"].FileName)
+	assertions.Equal("external_test.go", testFileDetailsByPackage["example.com/xt"]["TestExternal"].FileName)
+	assertions.Nil(testFileDetailsByPackage["example.com/xt_test [example.com/xt.test]"])
+	assertions.Equal("example.com/xt", goListByPackage["example.com/xt"].ImportPath)
+
+	// the external test package is listed before the package
+	listFile = filepath.Join(dir, "list_xtest.json")
+	lines := strings.Split(strings.TrimSpace(data), "\n")
+	if err := ioutil.WriteFile(listFile, []byte(lines[3]+"\n"+lines[2]), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testFileDetailsByPackage, goListByPackage, err = getAllDetails(listFile)
+	assertions.Nil(err)
+	assertions.Len(testFileDetailsByPackage["example.com/xt"], 2)
+	assertions.Equal("example.com/xt [example.com/xt.test]", goListByPackage["example.com/xt"].ImportPath)
+}
+
+func TestTestedPackagePath(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Equal("foo", testedPackagePath(&goListJSON{ImportPath: "foo"}))
+	assertions.Equal("foo", testedPackagePath(&goListJSON{ImportPath: "foo [foo.test]", ForTest: "foo"}))
+	assertions.Equal("foo", testedPackagePath(&goListJSON{ImportPath: "foo_test [foo.test]", ForTest: "foo"}))
+	// without the ForTest field the tested package is read from the test binary
+	assertions.Equal("foo", testedPackagePath(&goListJSON{ImportPath: "foo_test [foo.test]"}))
+	assertions.Equal("bar_test", testedPackagePath(&goListJSON{ImportPath: "bar_test [bar_test.test]"}))
+	assertions.Equal("foo.test", testedPackagePath(&goListJSON{ImportPath: "foo.test"}))
 }
 
 func TestGenerateReport(t *testing.T) {
@@ -315,7 +463,7 @@ func TestGenerateReport(t *testing.T) {
 	}
 	elapsedTestTime := 3 * time.Second
 	writer := bufio.NewWriter(&bytes.Buffer{})
-	err := generateReport(tmplData, allTests, testFileDetailsByPackage, elapsedTestTime, writer)
+	err := generateReport(tmplData, allTests, nil, testFileDetailsByPackage, elapsedTestTime, writer)
 	assertions.Nil(err)
 	assertions.Equal(2, tmplData.NumOfTestPassed)
 	assertions.Equal(1, tmplData.NumOfTestFailed)
@@ -356,9 +504,8 @@ func TestSameTestName(t *testing.T) {
 {"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"bar","Test":"Test","Output":"--- FAIL: Test (0.5s)\n"}
 {"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"bar","Test":"Test","Elapsed":0.5}
 `
-	stdinScanner := bufio.NewScanner(strings.NewReader(data))
 	cmd := &cobra.Command{}
-	allPackageNames, allTests, err := readTestDataFromStdIn(stdinScanner, flags, cmd)
+	allPackageNames, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), flags, cmd)
 	assertions.Nil(err)
 	assertions.Len(allPackageNames, 2)
 	assertions.Contains(allPackageNames, "foo")
@@ -366,6 +513,451 @@ func TestSameTestName(t *testing.T) {
 	assertions.Len(allTests, 2)
 }
 
+func TestReadTestDataFromStdInWithPackageEvents(t *testing.T) {
+	assertions := assert.New(t)
+	flags := &cmdFlags{}
+	data := `{"ImportPath":"broken [broken.test]","Action":"build-output","Output":"# broken [broken.test]\n"}
+{"ImportPath":"broken [broken.test]","Action":"build-output","Output":"./broken_test.go:7:2: undefined: foo\n"}
+{"ImportPath":"broken [broken.test]","Action":"build-fail"}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"start","Package":"broken"}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"output","Package":"broken","Output":"FAIL\tbroken [build failed]\n"}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"fail","Package":"broken","Elapsed":0,"FailedBuild":"broken [broken.test]"}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"output","Package":"empty","Output":"?   \tempty\t[no test files]\n"}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"skip","Package":"empty","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"panic: TestMain exploded\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"FAIL\tfoo\t0.512s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Elapsed":0.512}
+`
+	cmd := &cobra.Command{}
+	allPackageNames, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), flags, cmd)
+	assertions.Nil(err)
+	assertions.Len(allPackageNames, 1)
+	assertions.Contains(allPackageNames, "foo")
+	assertions.Len(allTests, 1)
+	assertions.Len(allPackages, 3)
+
+	pkg := allPackages["broken"]
+	assertions.Equal("broken", pkg.Name)
+	assertions.False(pkg.Passed)
+	assertions.True(pkg.BuildFailed)
+	assertions.Equal([]string{"# broken [broken.test]\n", "./broken_test.go:7:2: undefined: foo\n"}, pkg.BuildOutput)
+	assertions.Equal([]string{"FAIL\tbroken [build failed]\n"}, pkg.Output)
+
+	pkg = allPackages["empty"]
+	assertions.True(pkg.Skipped)
+	assertions.True(pkg.NoTestFiles)
+	assertions.False(pkg.BuildFailed)
+
+	pkg = allPackages["foo"]
+	assertions.False(pkg.Passed)
+	assertions.False(pkg.Skipped)
+	assertions.False(pkg.BuildFailed)
+	assertions.Equal(0.512, pkg.ElapsedTime)
+	assertions.Len(pkg.Output, 2)
+}
+
+func TestGenerateReportWithPackages(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	allTests := map[string]*testStatus{
+		"foo.TestFunc1": {TestName: "TestFunc1", Package: "foo", Passed: true},
+	}
+	allPackages := map[string]*packageStatus{
+		"foo":    {Name: "foo", Passed: false, Output: []string{"panic: TestMain exploded\n"}},
+		"empty":  {Name: "empty", Skipped: true, NoTestFiles: true},
+		"broken": {Name: "broken", BuildFailed: true, BuildOutput: []string{"undefined: foo\n"}},
+		"bar":    {Name: "bar", Passed: true},
+	}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage{}, time.Second, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Equal(1, tmplData.NumOfTestPassed)
+	assertions.Equal(0, tmplData.NumOfTestFailed)
+	assertions.Equal(2, tmplData.NumOfPackagesFailed)
+	assertions.Len(tmplData.Packages, 4)
+	assertions.Equal("bar", tmplData.Packages[0].Name)
+	assertions.Equal("broken", tmplData.Packages[1].Name)
+	assertions.Equal("empty", tmplData.Packages[2].Name)
+	assertions.Equal("foo", tmplData.Packages[3].Name)
+	assertions.Contains(buffer.String(), "Failed Packages: <strong>2</strong>")
+	assertions.Contains(buffer.String(), "undefined: foo")
+}
+
+func TestReadTestDataFromStdInWithNonJSONLines(t *testing.T) {
+	assertions := assert.New(t)
+	data := `go: downloading github.com/stretchr/testify v1.6.1
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+stray output of a build script
+
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, rawOutput, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.Len(allTests, 1)
+	assertions.True(allTests["foo.TestFunc1"].Passed)
+	assertions.Equal([]*rawOutputLine{
+		{LineNumber: 1, Offset: 0, Text: "go: downloading github.com/stretchr/testify v1.6.1"},
+		{LineNumber: 3, Offset: int64(strings.Index(data, "stray")), Text: "stray output of a build script"},
+	}, rawOutput)
+
+	_, _, _, _, err = readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{strict: true}, cmd)
+	assertions.EqualError(err, "malformed go test -json event on line 1 (byte offset 0): invalid character 'g' looking for beginning of value")
+}
+
+func TestReadTestDataFromStdInWithLongLines(t *testing.T) {
+	assertions := assert.New(t)
+	longOutput := strings.Repeat("0123456789abcdef", 256*1024) + "\n"
+	longOutputJSON, err := json.Marshal(longOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	longLine := `{"Action":"output","Package":"foo","Test":"TestFunc1","Output":` + string(longOutputJSON) + `}`
+	data := `{"Action":"run","Package":"foo","Test":"TestFunc1"}
+` + longLine + `
+` + longLine + `
+{"Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Action":"pass","Package":"foo","Test":"TestFunc2","Elapsed":0.5}`
+	cmd := &cobra.Command{}
+	_, allTests, _, rawOutput, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{strict: true}, cmd)
+	assertions.Nil(err)
+	assertions.Empty(rawOutput)
+	assertions.Len(allTests, 2)
+	assertions.Len(allTests["foo.TestFunc1"].Output, 4)
+	assertions.Equal(longOutput, allTests["foo.TestFunc1"].Output[1])
+	assertions.Equal(longOutput, allTests["foo.TestFunc1"].Output[2])
+	assertions.True(allTests["foo.TestFunc1"].Passed)
+	assertions.Equal(0.5, allTests["foo.TestFunc2"].ElapsedTime)
+
+	// the byte offset of malformed input following the long lines
+	malformedData := `{"Action":"run","Package":"foo","Test":"TestFunc1"}
+` + longLine + `
+{"Action":"pass","Package":"foo"`
+	_, _, _, _, err = readTestDataFromStdIn(strings.NewReader(malformedData), &cmdFlags{strict: true}, cmd)
+	expectedOffset := len(`{"Action":"run","Package":"foo","Test":"TestFunc1"}`) + 1 + len(longLine) + 1
+	assertions.EqualError(err, fmt.Sprintf("malformed go test -json event on line 3 (byte offset %d): unexpected end of JSON input", expectedOffset))
+}
+
+func TestReadTestDataFromStdInWithRepeatedRuns(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Action":"run","Package":"foo","Test":"TestFlaky"}
+{"Action":"output","Package":"foo","Test":"TestFlaky","Output":"    foo_test.go:9: unlucky\n"}
+{"Action":"fail","Package":"foo","Test":"TestFlaky","Elapsed":0.5}
+{"Action":"run","Package":"foo","Test":"TestStable"}
+{"Action":"pass","Package":"foo","Test":"TestStable","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestFlaky"}
+{"Action":"output","Package":"foo","Test":"TestFlaky","Output":"    foo_test.go:11: lucky\n"}
+{"Action":"pass","Package":"foo","Test":"TestFlaky","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestStable"}
+{"Action":"pass","Package":"foo","Test":"TestStable","Elapsed":0.5}
+{"Action":"run","Package":"foo","Test":"TestSkipped"}
+{"Action":"skip","Package":"foo","Test":"TestSkipped"}
+{"Action":"run","Package":"foo","Test":"TestSkipped"}
+{"Action":"skip","Package":"foo","Test":"TestSkipped"}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+
+	flaky := allTests["foo.TestFlaky"]
+	assertions.False(flaky.Passed)
+	assertions.False(flaky.Skipped)
+	assertions.True(flaky.Flaky)
+	assertions.Equal(0.75, flaky.ElapsedTime)
+	assertions.Len(flaky.Attempts, 2)
+	assertions.False(flaky.Attempts[0].Passed)
+	assertions.Equal(0.5, flaky.Attempts[0].ElapsedTime)
+	assertions.Equal([]string{"", "    foo_test.go:9: unlucky\n", ""}, flaky.Attempts[0].Output)
+	assertions.True(flaky.Attempts[1].Passed)
+	assertions.Equal([]string{"", "    foo_test.go:11: lucky\n", ""}, flaky.Attempts[1].Output)
+	assertions.Len(flaky.Output, 6)
+
+	stable := allTests["foo.TestStable"]
+	assertions.True(stable.Passed)
+	assertions.False(stable.Flaky)
+	assertions.Equal(0.75, stable.ElapsedTime)
+	assertions.Len(stable.Attempts, 2)
+
+	skipped := allTests["foo.TestSkipped"]
+	assertions.True(skipped.Skipped)
+	assertions.False(skipped.Passed)
+	assertions.False(skipped.Flaky)
+
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	err = generateReport(tmplData, allTests, nil, testFileDetailsByPackage{}, 0, bufio.NewWriter(&bytes.Buffer{}))
+	assertions.Nil(err)
+	assertions.Equal(1, tmplData.NumOfTestPassed)
+	assertions.Equal(1, tmplData.NumOfTestFailed)
+	assertions.Equal(1, tmplData.NumOfTestSkipped)
+	assertions.Equal(1, tmplData.NumOfTestFlaky)
+}
+
+func TestTestStatusMarshalJSON(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Action":"run","Package":"foo","Test":"TestOnce"}
+{"Action":"output","Package":"foo","Test":"TestOnce","Output":"once\n"}
+{"Action":"pass","Package":"foo","Test":"TestOnce","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestTwice"}
+{"Action":"output","Package":"foo","Test":"TestTwice","Output":"first\n"}
+{"Action":"fail","Package":"foo","Test":"TestTwice","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestTwice"}
+{"Action":"output","Package":"foo","Test":"TestTwice","Output":"second\n"}
+{"Action":"pass","Package":"foo","Test":"TestTwice","Elapsed":0.25}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+
+	// the output of a test that ran once is not repeated by its only attempt
+	once, err := json.Marshal(allTests["foo.TestOnce"])
+	assertions.Nil(err)
+	assertions.Contains(string(once), `"Output":["","once\n",""]`)
+	assertions.Contains(string(once), `"Attempts":null`)
+	assertions.Len(allTests["foo.TestOnce"].Attempts, 1)
+
+	twice, err := json.Marshal(allTests["foo.TestTwice"])
+	assertions.Nil(err)
+	assertions.Contains(string(twice), `"Attempts":[{"ElapsedTime":0.25,"Output":["","first\n",""]`)
+
+	// the subtests of a test are encoded the same way
+	parent := &testStatus{TestName: "TestParent", Subtests: []*testStatus{allTests["foo.TestOnce"]}}
+	encoded, err := json.Marshal(parent)
+	assertions.Nil(err)
+	assertions.Equal(1, strings.Count(string(encoded), "once\\n"))
+
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err = generateReport(&templateData{numOfTestsPerGroup: 20}, allTests, nil, testFileDetailsByPackage{}, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Equal(1, strings.Count(buffer.String(), "once\\n"))
+}
+
+func TestReadTestDataFromStdInWithIncompleteTests(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Action":"run","Package":"foo","Test":"TestFunc2/case1"}
+{"Action":"fail","Package":"foo","Test":"TestFunc2/case1","Elapsed":0.5}
+{"Action":"run","Package":"foo","Test":"TestFunc2/case2"}
+{"Action":"run","Package":"foo","Test":"TestFunc3"}
+{"Action":"pass","Package":"foo","Test":"TestFunc3","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestFunc3"}
+{"Action":"output","Package":"foo","Test":"TestFunc3","Output":"=== RUN   TestFunc3\n"}
+`
+	cmd := &cobra.Command{}
+	_, allTests, _, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.False(allTests["foo.TestFunc1"].Incomplete)
+	assertions.True(allTests["foo.TestFunc1"].Passed)
+	// a test that failed is not incomplete, even if one of its subtests is
+	assertions.False(allTests["foo.TestFunc2/case1"].Incomplete)
+	assertions.True(allTests["foo.TestFunc2/case2"].Incomplete)
+	assertions.False(allTests["foo.TestFunc2/case2"].Passed)
+	assertions.False(allTests["foo.TestFunc2/case2"].Skipped)
+	assertions.True(allTests["foo.TestFunc2"].Incomplete)
+	// the outcome of a test is unknown if its last attempt is incomplete
+	assertions.True(allTests["foo.TestFunc3"].Incomplete)
+	assertions.False(allTests["foo.TestFunc3"].Passed)
+	assertions.Equal("incomplete", testStatusName(allTests["foo.TestFunc3"]))
+	assertions.False(allTests["foo.TestFunc3"].Attempts[0].Incomplete)
+	assertions.True(allTests["foo.TestFunc3"].Attempts[1].Incomplete)
+
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	err = generateReport(tmplData, allTests, nil, testFileDetailsByPackage{}, 0, bufio.NewWriter(&bytes.Buffer{}))
+	assertions.Nil(err)
+	assertions.Equal(5, tmplData.NumOfTests)
+	assertions.Equal(1, tmplData.NumOfTestPassed)
+	assertions.Equal(1, tmplData.NumOfTestFailed)
+	assertions.Equal(3, tmplData.NumOfTestIncomplete)
+	assertions.Equal("failed", tmplData.TestResults[0].FailureIndicator)
+	assertions.Equal("incomplete", tmplData.TestResults[0].IncompleteIndicator)
+	testFunc2 := tmplData.TestResults[0].TestResults[1]
+	assertions.Equal(1, testFunc2.NumOfSubtestsFailed)
+	assertions.Equal(1, testFunc2.NumOfSubtestsIncomplete)
+}
+
+func TestReadTestDataFromStdInWithTimestamps(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.100000-05:00","Action":"start","Package":"foo"}
+{"Time":"2020-07-10T01:24:44.200000-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:45.450000-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":1.25}
+{"Time":"2020-07-10T01:24:45.500000-05:00","Action":"pass","Package":"foo","Elapsed":1.4}
+{"Time":"2020-07-10T01:24:44.300000-05:00","Action":"run","Package":"bar","Test":"TestFunc2"}
+{"Time":"invalid","Action":"output","Package":"bar","Test":"TestFunc2","Output":"=== RUN   TestFunc2\n"}
+{"Time":"2020-07-10T01:24:46.300000-05:00","Action":"fail","Package":"bar","Test":"TestFunc2","Elapsed":2}
+{"Time":"2020-07-10T01:24:46.400000-05:00","Action":"fail","Package":"bar","Elapsed":2.1}
+{"Action":"run","Package":"baz","Test":"TestFunc3"}
+{"Action":"pass","Package":"baz","Test":"TestFunc3","Elapsed":0.5}
+`
+	cmd := &cobra.Command{}
+	_, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	location := time.FixedZone("", -5*60*60)
+	testFunc1 := allTests["foo.TestFunc1"]
+	assertions.True(time.Date(2020, 7, 10, 1, 24, 44, 200000000, location).Equal(*testFunc1.StartTime))
+	assertions.True(time.Date(2020, 7, 10, 1, 24, 45, 450000000, location).Equal(*testFunc1.EndTime))
+	// events without a valid timestamp are ignored
+	testFunc2 := allTests["bar.TestFunc2"]
+	assertions.Equal(2*time.Second, testFunc2.EndTime.Sub(*testFunc2.StartTime))
+	assertions.Nil(allTests["baz.TestFunc3"].StartTime)
+	assertions.Nil(allTests["baz.TestFunc3"].EndTime)
+
+	start, end := testRunTimes(allPackages)
+	assertions.True(time.Date(2020, 7, 10, 1, 24, 44, 100000000, location).Equal(*start))
+	assertions.Equal(2300*time.Millisecond, end.Sub(*start))
+
+	start, end = testRunTimes(map[string]*packageStatus{"baz": allPackages["baz"]})
+	assertions.Nil(start)
+	assertions.Nil(end)
+}
+
+func TestGenerateReportWithTestStartTime(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &templateData{
+		numOfTestsPerGroup: 20,
+		testStartTime:      time.Date(2020, 7, 10, 1, 24, 44, 0, time.FixedZone("", -5*60*60)),
+	}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, map[string]*testStatus{}, nil, testFileDetailsByPackage{}, 2300*time.Millisecond, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Equal("July 10, 2020 01:24:44", tmplData.TestExecutionDate)
+	assertions.Equal(2300*time.Millisecond, tmplData.TestDuration)
+	assertions.Contains(buffer.String(), `<span class="testExecutionDate">July 10, 2020 01:24:44</span>`)
+}
+
+func TestGenerateReportWithRawOutput(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &templateData{
+		numOfTestsPerGroup: 20,
+		RawOutput:          []*rawOutputLine{{LineNumber: 7, Text: "go: downloading <module>"}},
+	}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, map[string]*testStatus{}, nil, testFileDetailsByPackage{}, time.Second, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Contains(buffer.String(), `<span class="lineNumber">7</span>go: downloading &lt;module&gt;`)
+}
+
+func TestReadTestDataFromFiles(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report-shards")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	shard1 := `{"Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+{"Action":"output","Package":"foo","Output":"ok  \tfoo\t0.250s\n"}
+{"Action":"pass","Package":"foo","Elapsed":0.25}
+`
+	shard2 := `{"Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Action":"fail","Package":"foo","Test":"TestFunc2","Elapsed":0.5}
+{"Action":"output","Package":"foo","Output":"FAIL\tfoo\t0.500s\n"}
+{"Action":"fail","Package":"foo","Elapsed":0.5}
+`
+	shard3 := `go: downloading example.com/module v1.0.0
+{"Action":"run","Package":"bar","Test":"TestFunc3"}
+{"Action":"pass","Package":"bar","Test":"TestFunc3","Elapsed":1}
+{"Action":"skip","Package":"bar","Elapsed":1}
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "shard-1.json"), []byte(shard1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	compressed := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(compressed)
+	if _, err := gzipWriter.Write([]byte(shard2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "shard-2.json.gz"), compressed.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "other.json"), []byte(shard3), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	fileNames := []string{filepath.Join(dir, "shard-*"), filepath.Join(dir, "other.json")}
+	allPackageNames, allTests, allPackages, rawOutput, err := readTestDataFromFiles(fileNames, &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	assertions.Len(allPackageNames, 2)
+	assertions.Len(allTests, 3)
+	assertions.True(allTests["foo.TestFunc1"].Passed)
+	assertions.Equal(filepath.Join(dir, "shard-1.json"), allTests["foo.TestFunc1"].Source)
+	assertions.False(allTests["foo.TestFunc2"].Passed)
+	assertions.Equal(filepath.Join(dir, "shard-2.json.gz"), allTests["foo.TestFunc2"].Source)
+	assertions.Equal(filepath.Join(dir, "other.json"), allTests["bar.TestFunc3"].Source)
+
+	foo := allPackages["foo"]
+	assertions.False(foo.Passed)
+	assertions.False(foo.Skipped)
+	assertions.Equal(0.75, foo.ElapsedTime)
+	assertions.Equal([]string{"ok  \tfoo\t0.250s\n", "FAIL\tfoo\t0.500s\n"}, foo.Output)
+	assertions.Equal([]string{filepath.Join(dir, "shard-1.json"), filepath.Join(dir, "shard-2.json.gz")}, foo.Sources)
+	assertions.True(allPackages["bar"].Skipped)
+
+	assertions.Equal([]*rawOutputLine{{
+		Source:     filepath.Join(dir, "other.json"),
+		LineNumber: 1,
+		Text:       "go: downloading example.com/module v1.0.0",
+	}}, rawOutput)
+
+	_, _, _, _, err = readTestDataFromFiles([]string{filepath.Join(dir, "missing.json")}, &cmdFlags{}, cmd)
+	assertions.Error(err)
+	_, _, _, _, err = readTestDataFromFiles([]string{filepath.Join(dir, "other.json")}, &cmdFlags{strict: true}, cmd)
+	assertions.EqualError(err, filepath.Join(dir, "other.json")+": malformed go test -json event on line 1 (byte offset 0): invalid character 'g' looking for beginning of value")
+}
+
+func TestRootCommandWithFiles(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report-files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	shards := map[string]string{
+		"shard-1.json": `{"Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.25}
+{"Action":"pass","Package":"foo","Elapsed":0.25}
+`,
+		"shard-2.json": `{"Action":"run","Package":"bar","Test":"TestFunc2"}
+{"Action":"pass","Package":"bar","Test":"TestFunc2","Elapsed":0.5}
+{"Action":"pass","Package":"bar","Elapsed":0.5}
+`,
+		// the go list output of the packages, which do not exist
+		"list.json": "",
+	}
+	for name, content := range shards {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	outputFile := filepath.Join(dir, "report.html")
+	rootCmd, tmplData, _ := initRootCommand()
+	rootCmd.SetOut(&bytes.Buffer{})
+	rootCmd.SetErr(&bytes.Buffer{})
+	rootCmd.SetArgs([]string{"-o", outputFile, "-l", filepath.Join(dir, "list.json"), filepath.Join(dir, "shard-1.json"), filepath.Join(dir, "shard-[2-9].json")})
+	assertions.Nil(rootCmd.Execute())
+	assertions.Equal(2, tmplData.NumOfTests)
+	assertions.Equal(2, tmplData.NumOfTestPassed)
+	report, err := ioutil.ReadFile(outputFile)
+	assertions.Nil(err)
+	assertions.Contains(string(report), `"TestName":"TestFunc1"`)
+	assertions.Contains(string(report), `"TestName":"TestFunc2"`)
+}
+
 func TestParseSizeFlagIfValueIsNotInteger(t *testing.T) {
 	assertions := assert.New(t)
 	tmplData := &templateData{}
@@ -410,3 +1002,69 @@ func TestParseSizeFlagIfMalformedSize(t *testing.T) {
 	assertions.Error(err)
 	assertions.Equal(err.Error(), `malformed size value; only one x is allowed if specifying with and height`)
 }
+
+func TestBuildTestTree(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"foo.TestParse":                   {TestName: "TestParse", Package: "foo", Passed: false},
+		"foo.TestParse/empty_input":       {TestName: "TestParse/empty_input", Package: "foo", Passed: true},
+		"foo.TestParse/bad_input":         {TestName: "TestParse/bad_input", Package: "foo", Passed: false},
+		"foo.TestParse/bad_input/unicode": {TestName: "TestParse/bad_input/unicode", Package: "foo", Skipped: true},
+		"foo.TestOther":                   {TestName: "TestOther", Package: "foo", Passed: true},
+		"bar.TestParse/orphan":            {TestName: "TestParse/orphan", Package: "bar", Passed: true},
+	}
+	rootTests := buildTestTree(allTests)
+	assertions.Len(rootTests, 3)
+	assertions.Equal("TestOther", rootTests[0].TestName)
+	assertions.Empty(rootTests[0].Subtests)
+	assertions.Equal("TestParse", rootTests[1].TestName)
+	assertions.Equal("foo", rootTests[1].Package)
+	assertions.Equal("TestParse/orphan", rootTests[2].TestName)
+
+	parse := rootTests[1]
+	assertions.Len(parse.Subtests, 2)
+	assertions.Equal("TestParse/bad_input", parse.Subtests[0].TestName)
+	assertions.Equal("TestParse/empty_input", parse.Subtests[1].TestName)
+	assertions.Len(parse.Subtests[0].Subtests, 1)
+	assertions.Equal("TestParse/bad_input/unicode", parse.Subtests[0].Subtests[0].TestName)
+	assertions.Equal(1, parse.NumOfSubtestsPassed)
+	assertions.Equal(1, parse.NumOfSubtestsFailed)
+	assertions.Equal(1, parse.NumOfSubtestsSkipped)
+	assertions.Equal(0, parse.Subtests[0].NumOfSubtestsPassed)
+	assertions.Equal(1, parse.Subtests[0].NumOfSubtestsSkipped)
+}
+
+func TestGenerateReportWithSubtests(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &templateData{numOfTestsPerGroup: 1}
+	allTests := map[string]*testStatus{
+		"foo.TestParse":             {TestName: "TestParse", Package: "foo", Passed: true},
+		"foo.TestParse/empty_input": {TestName: "TestParse/empty_input", Package: "foo", Passed: true},
+		"foo.TestParse/a/b":         {TestName: "TestParse/a/b", Package: "foo", Skipped: true},
+		"foo.TestOther":             {TestName: "TestOther", Package: "foo", Passed: false},
+	}
+	testFileDetailsByPackage := testFileDetailsByPackage{
+		"foo": {
+			"TestParse": &testFileDetail{
+				FileName:            "parse_test.go",
+				TestFunctionFilePos: testFunctionFilePos{Line: 12, Col: 1},
+			},
+		},
+	}
+	writer := bufio.NewWriter(&bytes.Buffer{})
+	err := generateReport(tmplData, allTests, nil, testFileDetailsByPackage, time.Second, writer)
+	assertions.Nil(err)
+	assertions.Equal(4, tmplData.NumOfTests)
+	assertions.Equal(2, tmplData.NumOfTestPassed)
+	assertions.Equal(1, tmplData.NumOfTestFailed)
+	assertions.Equal(1, tmplData.NumOfTestSkipped)
+	assertions.Len(tmplData.TestResults, 2)
+	assertions.Equal("TestOther", tmplData.TestResults[0].TestResults[0].TestName)
+	assertions.Equal("failed", tmplData.TestResults[0].FailureIndicator)
+	parse := tmplData.TestResults[1].TestResults[0]
+	assertions.Equal("TestParse", parse.TestName)
+	assertions.Equal("skipped", tmplData.TestResults[1].SkippedIndicator)
+	assertions.Len(parse.Subtests, 2)
+	assertions.Equal("parse_test.go", parse.Subtests[0].TestFileName)
+	assertions.Equal(12, parse.Subtests[0].TestFunctionDetail.Line)
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// the number of trailing output lines shown for every failed test
+	markdownMaxOutputLines = 50
+	// the number of tests listed in the slowest tests table
+	markdownNumOfSlowestTests = 10
+)
+
+// generateMarkdownReport writes a compact summary of the test results as GitHub flavored markdown, suitable for a
+// pull request comment or $GITHUB_STEP_SUMMARY. generateReport must be invoked first, since it adds the file
+// details to the tests and computes the totals.
+func generateMarkdownReport(tmplData *templateData, allTests map[string]*testStatus, writer io.Writer) error {
+	var tests []*testStatus
+	for _, status := range allTests {
+		tests = append(tests, status)
+	}
+	sort.Slice(tests, func(i, j int) bool {
+		if tests[i].Package != tests[j].Package {
+			return tests[i].Package < tests[j].Package
+		}
+		return tests[i].TestName < tests[j].TestName
+	})
+
+	md := &strings.Builder{}
+	fmt.Fprintf(md, "## %s\n\n", tmplData.ReportTitle)
+	// flaky tests are counted as failed, the other columns add up to the total
+	md.WriteString("| Total | Passed | Skipped | Failed | Incomplete | Flaky | Duration |\n")
+	md.WriteString("|---:|---:|---:|---:|---:|---:|---:|\n")
+	fmt.Fprintf(md, "| %d | %d | %d | %d | %d | %d | %s |\n\n",
+		tmplData.NumOfTests, tmplData.NumOfTestPassed, tmplData.NumOfTestSkipped, tmplData.NumOfTestFailed,
+		tmplData.NumOfTestIncomplete, tmplData.NumOfTestFlaky, tmplData.TestDuration)
+
+	if tmplData.NumOfPackagesFailed > 0 {
+		fmt.Fprintf(md, "### Failed packages (%d)\n\n", tmplData.NumOfPackagesFailed)
+		for _, pkg := range tmplData.Packages {
+			if pkg.Passed || pkg.Skipped {
+				continue
+			}
+			if pkg.BuildFailed {
+				fmt.Fprintf(md, "- :x: `%s` (build failed)\n", pkg.Name)
+				writeMarkdownDetails(md, "Build output", append(append([]string{}, pkg.BuildOutput...), pkg.Output...))
+			} else if pkg.Timeout != "" {
+				fmt.Fprintf(md, "- :x: `%s` (timed out after %s)\n", pkg.Name, pkg.Timeout)
+				var runningTests []string
+				for _, runningTest := range pkg.RunningTests {
+					runningTests = append(runningTests, runningTest+"\n")
+				}
+				writeMarkdownDetails(md, "Running tests", runningTests)
+			} else {
+				fmt.Fprintf(md, "- :x: `%s`\n", pkg.Name)
+				writeMarkdownDetails(md, "Output", pkg.Output)
+			}
+		}
+		md.WriteString("\n")
+	}
+
+	// a test that only failed because one of its subtests failed is not listed, its failed subtests are
+	var failedTests []*testStatus
+	for _, status := range tests {
+		if !status.Passed && !status.Skipped && !status.Incomplete && status.NumOfSubtestsFailed == 0 {
+			failedTests = append(failedTests, status)
+		}
+	}
+	if len(failedTests) > 0 {
+		fmt.Fprintf(md, "### Failed tests (%d)\n\n", len(failedTests))
+		for _, status := range failedTests {
+			location := ""
+			if status.TestFileName != "" {
+				location = fmt.Sprintf(" (`%s:%d`)", status.TestFileName, status.TestFunctionDetail.Line)
+			}
+			note := ""
+			if status.TimedOut {
+				note = " (timed out)"
+			} else if status.Flaky {
+				note = fmt.Sprintf(" (flaky, failed %d of %d attempts)", numOfFailedAttempts(status), len(status.Attempts))
+			}
+			fmt.Fprintf(md, "- :x: `%s` in `%s`%s%s\n", status.TestName, status.Package, location, note)
+			if status.FailureSummary != "" {
+				fmt.Fprintf(md, "  %s\n", markdownCodeSpan(status.FailureSummary))
+			}
+			if status.FuzzFailure != nil {
+				fmt.Fprintf(md, "  To re-run: `%s`\n", status.FuzzFailure.ReproCommand)
+			}
+			writeMarkdownDetails(md, "Output", status.Output)
+		}
+		md.WriteString("\n")
+	}
+
+	var incompleteTests []*testStatus
+	for _, status := range tests {
+		if status.Incomplete {
+			incompleteTests = append(incompleteTests, status)
+		}
+	}
+	if len(incompleteTests) > 0 {
+		fmt.Fprintf(md, "### Incomplete tests (%d)\n\n", len(incompleteTests))
+		for _, status := range incompleteTests {
+			fmt.Fprintf(md, "- :grey_question: `%s` in `%s`\n", status.TestName, status.Package)
+		}
+		md.WriteString("\n")
+	}
+
+	if tmplData.Coverage != nil {
+		md.WriteString("### Coverage\n\n")
+		md.WriteString("| Package | Coverage |\n")
+		md.WriteString("|---|---:|\n")
+		for _, pkg := range tmplData.Packages {
+			if pkg.Coverage == nil {
+				continue
+			}
+			fmt.Fprintf(md, "| `%s` | %s |\n", escapeMarkdownTableCell(pkg.Name), markdownCoverage(pkg.Coverage))
+		}
+		fmt.Fprintf(md, "| **Total** | %s |\n\n", markdownCoverage(tmplData.Coverage))
+	}
+
+	slowestTests := make([]*testStatus, 0, len(tests))
+	for _, status := range tests {
+		if status.ElapsedTime > 0 {
+			slowestTests = append(slowestTests, status)
+		}
+	}
+	sort.SliceStable(slowestTests, func(i, j int) bool {
+		return slowestTests[i].ElapsedTime > slowestTests[j].ElapsedTime
+	})
+	if len(slowestTests) > markdownNumOfSlowestTests {
+		slowestTests = slowestTests[:markdownNumOfSlowestTests]
+	}
+	if len(slowestTests) > 0 {
+		md.WriteString("### Slowest tests\n\n")
+		md.WriteString("| Test | Package | Duration |\n")
+		md.WriteString("|---|---|---:|\n")
+		for _, status := range slowestTests {
+			fmt.Fprintf(md, "| `%s` | `%s` | %.2fs |\n",
+				escapeMarkdownTableCell(status.TestName), escapeMarkdownTableCell(status.Package), status.ElapsedTime)
+		}
+		md.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, md.String())
+	return err
+}
+
+// writeMarkdownDetails writes the output as a collapsible details block, keeping only the last
+// markdownMaxOutputLines lines of the output.
+func writeMarkdownDetails(md *strings.Builder, summary string, output []string) {
+	lines := strings.Split(strings.TrimRight(strings.Join(output, ""), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return
+	}
+	if len(lines) > markdownMaxOutputLines {
+		omitted := len(lines) - markdownMaxOutputLines
+		lines = append([]string{fmt.Sprintf("... (%d lines omitted)", omitted)}, lines[omitted:]...)
+	}
+	text := strings.Join(lines, "\n")
+	// the code fence must be longer than any run of backticks inside of the output
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(md, "  <details><summary>%s</summary>\n\n", summary)
+	fmt.Fprintf(md, "  %stext\n", fence)
+	for _, line := range lines {
+		fmt.Fprintf(md, "  %s\n", line)
+	}
+	fmt.Fprintf(md, "  %s\n\n", fence)
+	md.WriteString("  </details>\n\n")
+}
+
+// numOfFailedAttempts returns the number of attempts of the test that neither passed nor were skipped.
+func numOfFailedAttempts(status *testStatus) int {
+	failed := 0
+	for _, attempt := range status.Attempts {
+		if !attempt.Passed && !attempt.Skipped {
+			failed++
+		}
+	}
+	return failed
+}
+
+// markdownCoverage formats a coverage percentage, marking it if it is below the --min-coverage threshold.
+func markdownCoverage(coverage *packageCoverage) string {
+	if coverage.BelowMinimum {
+		return fmt.Sprintf(":warning: %.1f%%", coverage.Percent)
+	}
+	return fmt.Sprintf("%.1f%%", coverage.Percent)
+}
+
+// markdownCodeSpan formats the text as inline code, delimited by a run of backticks longer than any run of backticks
+// inside of the text.
+func markdownCodeSpan(text string) string {
+	delimiter := "`"
+	for strings.Contains(text, delimiter) {
+		delimiter += "`"
+	}
+	// a space separates backticks at the start or the end of the text from the delimiter
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+	return delimiter + text + delimiter
+}
+
+// escapeMarkdownTableCell escapes the characters that would break a markdown table row.
+func escapeMarkdownTableCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateMarkdownReport(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &templateData{
+		ReportTitle:        "test-title",
+		numOfTestsPerGroup: 20,
+		MinCoverage:        80,
+	}
+	var longOutput []string
+	for i := 1; i <= 60; i++ {
+		longOutput = append(longOutput, fmt.Sprintf("line %d\n", i))
+	}
+	allTests := map[string]*testStatus{
+		"foo.TestFunc1":      {TestName: "TestFunc1", Package: "foo", ElapsedTime: 1.25, Passed: true},
+		"foo.TestFunc2":      {TestName: "TestFunc2", Package: "foo", ElapsedTime: 0.5, Output: longOutput},
+		"foo.TestFunc3":      {TestName: "TestFunc3", Package: "foo", ElapsedTime: 2},
+		"foo.TestFunc4":      {TestName: "TestFunc4", Package: "foo", Flaky: true, Attempts: []*testAttempt{{Passed: true}, {}, {Passed: true}}},
+		"foo.TestFunc5":      {TestName: "TestFunc5", Package: "foo", Incomplete: true},
+		"foo.TestFunc3/case": {TestName: "TestFunc3/case", Package: "foo", ElapsedTime: 2, Output: []string{"    foo_test.go:30: ```got``` 1\n"}, FailureSummary: "foo_test.go:30: ```got``` 1"},
+	}
+	allPackages := map[string]*packageStatus{
+		"foo":    {Name: "foo", Coverage: &packageCoverage{Percent: 72.25}},
+		"broken": {Name: "broken", BuildFailed: true, BuildOutput: []string{"./broken_test.go:7:2: undefined: foo\n"}},
+	}
+	testFileDetailsByPackage := testFileDetailsByPackage{
+		"foo": {
+			"TestFunc2": &testFileDetail{
+				FileName:            "foo_test.go",
+				TestFunctionFilePos: testFunctionFilePos{Line: 21, Col: 1},
+			},
+		},
+	}
+	err := generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage, 3*time.Second, bufio.NewWriter(&bytes.Buffer{}))
+	assertions.Nil(err)
+
+	buffer := &bytes.Buffer{}
+	err = generateMarkdownReport(tmplData, allTests, buffer)
+	assertions.Nil(err)
+	md := buffer.String()
+	assertions.True(strings.HasPrefix(md, "## test-title\n"))
+	assertions.Contains(md, "| Total | Passed | Skipped | Failed | Incomplete | Flaky | Duration |\n")
+	assertions.Contains(md, "| 6 | 1 | 0 | 4 | 1 | 1 | 3s |")
+	assertions.Contains(md, "### Failed packages (2)")
+	assertions.Contains(md, "- :x: `broken` (build failed)")
+	assertions.Contains(md, "./broken_test.go:7:2: undefined: foo")
+	assertions.Contains(md, "### Failed tests (3)")
+	assertions.Contains(md, "- :x: `TestFunc2` in `foo` (`foo_test.go:21`)")
+	assertions.Contains(md, "- :x: `TestFunc3/case` in `foo`\n  ````foo_test.go:30: ```got``` 1````\n")
+	assertions.NotContains(md, "- :x: `TestFunc3` in `foo`")
+	assertions.Contains(md, "- :x: `TestFunc4` in `foo` (flaky, failed 1 of 3 attempts)")
+	assertions.Contains(md, "... (10 lines omitted)")
+	assertions.NotContains(md, "line 10\n")
+	assertions.Contains(md, "line 11\n")
+	assertions.Contains(md, "````text")
+	assertions.Contains(md, "| `foo` | :warning: 72.2% |\n| **Total** | :warning: 72.2% |")
+	assertions.NotContains(md, "| `broken` |")
+	assertions.Contains(md, "### Slowest tests")
+	assertions.Contains(md, "| `TestFunc3` | `foo` | 2.00s |\n| `TestFunc3/case` | `foo` | 2.00s |\n| `TestFunc1` | `foo` | 1.25s |")
+}
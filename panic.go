@@ -0,0 +1,147 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// panicDetail is a panic found in the output of a test, including the stacks of the goroutines printed by the
+	// go runtime.
+	panicDetail struct {
+		// the value passed to panic, e.g. "runtime error: index out of range [3] with length 3", including any
+		// following lines such as "[signal SIGSEGV: segmentation violation ...]"
+		Value      string
+		Goroutines []*goroutineStack
+	}
+
+	goroutineStack struct {
+		ID     int
+		State  string
+		Frames []*stackFrame
+	}
+
+	stackFrame struct {
+		// the function including its package path, e.g. "example.com/module/pkg.(*Parser).Parse"
+		Function string
+		File     string
+		Line     int
+		// true for the frame of the function that created the goroutine
+		CreatedBy bool
+		// true for the frames of functions in the module under test
+		InModule bool
+		// true for the frames of functions in the runtime or testing packages, which are collapsed in the report
+		Runtime bool
+	}
+)
+
+var (
+	// e.g. "goroutine 8 [running]:" or "goroutine 21 [chan receive, 9 minutes]:"
+	goroutineHeaderRegexp = regexp.MustCompile(`^goroutine (\d+) \[([^\]]*)\]:$`)
+	// e.g. "\t/usr/local/go/src/testing/testing.go:1595 +0x238"
+	stackFrameFileRegexp = regexp.MustCompile(`^\t(.+):(\d+)(?: \+0x[0-9a-f]+)?$`)
+)
+
+// parsePanic returns the first panic found in the output, or nil if the output does not contain a panic.
+func parsePanic(output []string, modulePath string) *panicDetail {
+	lines := strings.Split(strings.Join(output, ""), "\n")
+	start := -1
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic: ") {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+	detail := &panicDetail{}
+	i := start
+	var valueLines []string
+	for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+		valueLines = append(valueLines, lines[i])
+	}
+	detail.Value = strings.TrimPrefix(strings.Join(valueLines, "\n"), "panic: ")
+
+	var goroutine *goroutineStack
+	for ; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], "\r")
+		if match := goroutineHeaderRegexp.FindStringSubmatch(line); match != nil {
+			id, _ := strconv.Atoi(match[1])
+			goroutine = &goroutineStack{ID: id, State: match[2]}
+			detail.Goroutines = append(detail.Goroutines, goroutine)
+			continue
+		}
+		if goroutine == nil || line == "" || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		// the output of go test following the stacks, e.g. "FAIL\tpkg\t0.012s"
+		if i+1 >= len(lines) {
+			break
+		}
+		match := stackFrameFileRegexp.FindStringSubmatch(strings.TrimRight(lines[i+1], "\r"))
+		if match == nil {
+			goroutine = nil
+			continue
+		}
+		createdBy := strings.HasPrefix(line, "created by ")
+		if createdBy {
+			line = strings.TrimPrefix(line, "created by ")
+			// e.g. "testing.(*T).Run in goroutine 7"
+			if j := strings.Index(line, " in goroutine "); j > 0 {
+				line = line[:j]
+			}
+		}
+		frame := newStackFrame(line, match[1], match[2], modulePath)
+		frame.CreatedBy = createdBy
+		goroutine.Frames = append(goroutine.Frames, frame)
+		i++
+	}
+	return detail
+}
+
+// newStackFrame returns a frame of a stack trace printed by the go runtime from the function call, e.g.
+// "pkg.Func(0xc000010000, {0x6b6ef0?, 0x6ef150?})" or "pkg.Func(...)", and the file and line of the call.
+func newStackFrame(call string, file string, line string, modulePath string) *stackFrame {
+	frame := &stackFrame{File: file}
+	frame.Line, _ = strconv.Atoi(line)
+	// the arguments of the call, e.g. "(0xc000010000, {0x6b6ef0?, 0x6ef150?})" or "(...)"
+	if strings.HasSuffix(call, ")") {
+		if j := strings.LastIndex(call, "("); j > 0 {
+			call = call[:j]
+		}
+	}
+	frame.Function = call
+	packagePath := stackFramePackagePath(frame.Function)
+	frame.Runtime = packagePath == "" || packagePath == "runtime" || packagePath == "testing" ||
+		strings.HasPrefix(packagePath, "runtime/") || strings.HasPrefix(packagePath, "testing/")
+	frame.InModule = modulePath != "" && (packagePath == modulePath || strings.HasPrefix(packagePath, modulePath+"/"))
+	return frame
+}
+
+// stackFramePackagePath returns the package path of a function in a stack trace, e.g. "example.com/module/pkg" for
+// "example.com/module/pkg.(*Parser).Parse.func1". Builtin functions such as "panic" have no package path.
+func stackFramePackagePath(function string) string {
+	lastSlash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[lastSlash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+	return function[:lastSlash+1+dot]
+}
+
+// readPanics parses the panics in the output of the failed tests. Frames are highlighted if they belong to the
+// module of the package reported by go list, or to the package itself if the module is unknown.
+func readPanics(allTests map[string]*testStatus, goListByPackage goListJSONByPackage) {
+	for _, status := range allTests {
+		if status.Passed || status.Skipped {
+			continue
+		}
+		modulePath := status.Package
+		if goList := goListByPackage[status.Package]; goList != nil && goList.Module.Path != "" {
+			modulePath = goList.Module.Path
+		}
+		status.Panic = parsePanic(status.Output, modulePath)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var panicOutput = []string{
+	"=== RUN   TestPanic\n",
+	"--- FAIL: TestPanic (0.00s)\n",
+	"panic: runtime error: invalid memory address or nil pointer dereference [recovered]\n",
+	"[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x5433d0]\n",
+	"\n",
+	"goroutine 8 [running]:\n",
+	"testing.tRunner.func1.2({0x6b6ef0, 0x6ef150})\n",
+	"\t/usr/local/go/src/testing/testing.go:2123 +0x232\n",
+	"panic({0x6b6ef0?, 0x6ef150?})\n",
+	"\t/usr/local/go/src/runtime/panic.go:859 +0x125\n",
+	"example.com/module/sub.Explode(...)\n",
+	"\t/src/module/sub/sub.go:5\n",
+	"example.com/module.TestPanic.func1(0xc000182000)\n",
+	"\t/src/module/module_test.go:13 +0x10\n",
+	"example.com/other.(*Client).Call(0xc000182000)\n",
+	"\t/go/pkg/mod/example.com/other/client.go:42 +0x1f\n",
+	"testing.tRunner(0xc000182000, 0x6d49e8)\n",
+	"\t/usr/local/go/src/testing/testing.go:2193 +0xea\n",
+	"created by testing.(*T).Run in goroutine 7\n",
+	"\t/usr/local/go/src/testing/testing.go:2258 +0x4d4\n",
+	"\n",
+	"goroutine 1 [chan receive]:\n",
+	"testing.(*T).Run(0xc000182000, {0x6a1b2c?, 0x0?}, 0x6d49e8)\n",
+	"\t/usr/local/go/src/testing/testing.go:2266 +0x4f5\n",
+	"FAIL\texample.com/module\t0.012s\n",
+}
+
+func TestParsePanic(t *testing.T) {
+	assertions := assert.New(t)
+	detail := parsePanic(panicOutput, "example.com/module")
+	assertions.NotNil(detail)
+	assertions.Equal("runtime error: invalid memory address or nil pointer dereference [recovered]\n[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x5433d0]", detail.Value)
+	assertions.Len(detail.Goroutines, 2)
+
+	goroutine := detail.Goroutines[0]
+	assertions.Equal(8, goroutine.ID)
+	assertions.Equal("running", goroutine.State)
+	assertions.Len(goroutine.Frames, 7)
+	assertions.Equal(&stackFrame{
+		Function: "testing.tRunner.func1.2",
+		File:     "/usr/local/go/src/testing/testing.go",
+		Line:     2123,
+		Runtime:  true,
+	}, goroutine.Frames[0])
+	assertions.Equal("panic", goroutine.Frames[1].Function)
+	assertions.True(goroutine.Frames[1].Runtime)
+	assertions.Equal(&stackFrame{
+		Function: "example.com/module/sub.Explode",
+		File:     "/src/module/sub/sub.go",
+		Line:     5,
+		InModule: true,
+	}, goroutine.Frames[2])
+	assertions.Equal("example.com/module.TestPanic.func1", goroutine.Frames[3].Function)
+	assertions.True(goroutine.Frames[3].InModule)
+	assertions.Equal("example.com/other.(*Client).Call", goroutine.Frames[4].Function)
+	assertions.False(goroutine.Frames[4].InModule)
+	assertions.False(goroutine.Frames[4].Runtime)
+	assertions.Equal(&stackFrame{
+		Function:  "testing.(*T).Run",
+		File:      "/usr/local/go/src/testing/testing.go",
+		Line:      2258,
+		CreatedBy: true,
+		Runtime:   true,
+	}, goroutine.Frames[6])
+
+	assertions.Equal(1, detail.Goroutines[1].ID)
+	assertions.Equal("chan receive", detail.Goroutines[1].State)
+	assertions.Len(detail.Goroutines[1].Frames, 1)
+
+	assertions.Nil(parsePanic([]string{"=== RUN   TestFunc\n", "    foo_test.go:12: panic: not really\n"}, "example.com/module"))
+}
+
+func TestReadPanics(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"example.com/module.TestPanic":     {TestName: "TestPanic", Package: "example.com/module", Output: panicOutput},
+		"example.com/module.TestPass":      {TestName: "TestPass", Package: "example.com/module", Output: panicOutput, Passed: true},
+		"example.com/module/sub.TestPanic": {TestName: "TestPanic", Package: "example.com/module/sub", Output: panicOutput},
+	}
+	readPanics(allTests, goListJSONByPackage{
+		"example.com/module": {ImportPath: "example.com/module", Module: goListJSONModule{Path: "example.com/module"}},
+	})
+	assertions.Nil(allTests["example.com/module.TestPass"].Panic)
+	frames := allTests["example.com/module.TestPanic"].Panic.Goroutines[0].Frames
+	assertions.True(frames[2].InModule)
+	assertions.True(frames[3].InModule)
+	// without the module info only the frames of the package and its subpackages are highlighted
+	frames = allTests["example.com/module/sub.TestPanic"].Panic.Goroutines[0].Frames
+	assertions.True(frames[2].InModule)
+	assertions.False(frames[3].InModule)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// dataRace is a data race reported by the race detector of go test -race. A race reported more than once, e.g.
+	// by several tests calling the same racy code, is only listed once.
+	dataRace struct {
+		ID string
+		// the conflicting memory accesses, the access detecting the race first
+		Accesses []*raceAccess
+		// the goroutines of the accesses with the stacks of the functions that created them
+		Goroutines []*goroutineStack
+		// e.g. "Location is global 'counter' of size 8 at 0x000000832568 (pkg.test+0x000000832568)"
+		Location string
+		Tests    []*raceTest
+	}
+
+	raceAccess struct {
+		// e.g. "Read at 0x00c000014108 by goroutine 8" or "Previous write at 0x00c000014108 by main goroutine"
+		Description string
+		Frames      []*stackFrame
+		// e.g. "Read" or "Previous write"
+		kind string
+	}
+
+	raceTest struct {
+		TestName string
+		Package  string
+	}
+)
+
+const (
+	raceWarning   = "WARNING: DATA RACE"
+	raceSeparator = "=================="
+)
+
+var (
+	// e.g. "Read at 0x00c000014108 by goroutine 8:" or "Previous atomic write at 0x00c000014108 by main goroutine:"
+	raceAccessRegexp = regexp.MustCompile(`^(.+) at 0x[0-9a-f]+ by (?:goroutine \d+|main goroutine):$`)
+	// e.g. "Goroutine 8 (running) created at:"
+	raceGoroutineRegexp = regexp.MustCompile(`^Goroutine (\d+) \(([^)]*)\) created at:$`)
+	// e.g. "      /src/pkg/pkg.go:12 +0x31"
+	raceFrameFileRegexp = regexp.MustCompile(`^\s+(.+):(\d+)(?: \+0x[0-9a-f]+)?$`)
+)
+
+// parseRaces returns the data races found in the output, every race starts with a "WARNING: DATA RACE" line and
+// ends with a line of equal signs.
+func parseRaces(output []string, modulePath string) []*dataRace {
+	var races []*dataRace
+	var race *dataRace
+	var frames *[]*stackFrame
+	lines := strings.Split(strings.Join(output, ""), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], "\r")
+		if line == raceWarning {
+			race = &dataRace{}
+			races = append(races, race)
+			frames = nil
+			continue
+		}
+		if race == nil {
+			continue
+		}
+		if line == raceSeparator {
+			race = nil
+			continue
+		}
+		if match := raceAccessRegexp.FindStringSubmatch(line); match != nil {
+			access := &raceAccess{Description: strings.TrimSuffix(line, ":"), kind: match[1]}
+			race.Accesses = append(race.Accesses, access)
+			frames = &access.Frames
+		} else if match := raceGoroutineRegexp.FindStringSubmatch(line); match != nil {
+			id, _ := strconv.Atoi(match[1])
+			goroutine := &goroutineStack{ID: id, State: match[2]}
+			race.Goroutines = append(race.Goroutines, goroutine)
+			frames = &goroutine.Frames
+		} else if strings.HasPrefix(line, "Location is ") {
+			race.Location = line
+			frames = nil
+		} else if line != "" && !strings.HasPrefix(line, " ") {
+			// e.g. the creation of a mutex, which is not part of the report
+			frames = nil
+		} else if frames != nil && line != "" && i+1 < len(lines) {
+			if match := raceFrameFileRegexp.FindStringSubmatch(strings.TrimRight(lines[i+1], "\r")); match != nil {
+				*frames = append(*frames, newStackFrame(strings.TrimSpace(line), match[1], match[2], modulePath))
+				i++
+			}
+		}
+	}
+	return races
+}
+
+// raceKey identifies a data race by the kind and the location of its accesses, like the race detector does. The
+// addresses and goroutines of the accesses, as well as the callers of the racing functions (e.g. two tests calling
+// the same racy code), differ every time the race is reported.
+func raceKey(race *dataRace) string {
+	var key strings.Builder
+	for _, access := range race.Accesses {
+		key.WriteString(access.kind)
+		if len(access.Frames) > 0 {
+			fmt.Fprintf(&key, " %s:%d", access.Frames[0].File, access.Frames[0].Line)
+		}
+		key.WriteString("\n")
+	}
+	return key.String()
+}
+
+// readRaces parses the data races in the output of the tests and returns every distinct race, in the order of the
+// names of the tests reporting them. The IDs of the races are added to the tests reporting them. Frames are
+// highlighted if they belong to the module of the package reported by go list, just like the frames of a panic.
+func readRaces(allTests map[string]*testStatus, goListByPackage goListJSONByPackage) []*dataRace {
+	keys := make([]string, 0, len(allTests))
+	for key := range allTests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var races []*dataRace
+	racesByKey := map[string]*dataRace{}
+	for _, testKey := range keys {
+		status := allTests[testKey]
+		status.Races = nil
+		modulePath := status.Package
+		if goList := goListByPackage[status.Package]; goList != nil && goList.Module.Path != "" {
+			modulePath = goList.Module.Path
+		}
+		for _, race := range parseRaces(status.Output, modulePath) {
+			if len(race.Accesses) == 0 {
+				continue
+			}
+			key := raceKey(race)
+			if existing, exists := racesByKey[key]; exists {
+				race = existing
+			} else {
+				race.ID = fmt.Sprintf("race-%d", len(races)+1)
+				races = append(races, race)
+				racesByKey[key] = race
+			}
+			if !containsString(status.Races, race.ID) {
+				status.Races = append(status.Races, race.ID)
+				race.Tests = append(race.Tests, &raceTest{TestName: status.TestName, Package: status.Package})
+			}
+		}
+	}
+	return races
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
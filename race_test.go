@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// raceOutput returns the output of a test reporting a data race between Race and the goroutine it starts, the
+// goroutine started by Race has the given ID
+func raceOutput(testName string, goroutine int) []string {
+	return []string{
+		"=== RUN   " + testName + "\n",
+		"==================\n",
+		"WARNING: DATA RACE\n",
+		"Read at 0x000000832568 by goroutine 8:\n",
+		"  example.com/module.Inc()\n",
+		"      /src/module/module.go:6 +0x31\n",
+		"  example.com/module.Race.func1()\n",
+		"      /src/module/module.go:12 +0x25\n",
+		"\n",
+		"Previous write at 0x000000832568 by goroutine 7:\n",
+		"  example.com/module.Inc()\n",
+		"      /src/module/module.go:6 +0xbe\n",
+		"  example.com/module.Race()\n",
+		"      /src/module/module.go:15 +0x9a\n",
+		"  example.com/module." + testName + "()\n",
+		"      /src/module/module_test.go:6 +0x1c\n",
+		"  testing.tRunner()\n",
+		"      /usr/local/go/src/testing/testing.go:2193 +0x21c\n",
+		"\n",
+		"Location is global 'counter' of size 8 at 0x000000832568 (module.test+0x000000832568)\n",
+		"\n",
+		"Goroutine " + strconv.Itoa(goroutine) + " (running) created at:\n",
+		"  example.com/module.Race()\n",
+		"      /src/module/module.go:11 +0x99\n",
+		"\n",
+		"Goroutine 7 (finished) created at:\n",
+		"  testing.(*T).Run()\n",
+		"      /usr/local/go/src/testing/testing.go:2258 +0xb12\n",
+		"  main.main()\n",
+		"      _testmain.go:50 +0x164\n",
+		"==================\n",
+		"    testing.go:1865: race detected during execution of test\n",
+		"--- FAIL: " + testName + " (0.00s)\n",
+	}
+}
+
+func TestParseRaces(t *testing.T) {
+	assertions := assert.New(t)
+	races := parseRaces(raceOutput("TestRace", 8), "example.com/module")
+	assertions.Len(races, 1)
+	race := races[0]
+	assertions.Equal("Location is global 'counter' of size 8 at 0x000000832568 (module.test+0x000000832568)", race.Location)
+
+	assertions.Len(race.Accesses, 2)
+	assertions.Equal("Read at 0x000000832568 by goroutine 8", race.Accesses[0].Description)
+	assertions.Equal([]*stackFrame{
+		{Function: "example.com/module.Inc", File: "/src/module/module.go", Line: 6, InModule: true},
+		{Function: "example.com/module.Race.func1", File: "/src/module/module.go", Line: 12, InModule: true},
+	}, race.Accesses[0].Frames)
+	assertions.Equal("Previous write at 0x000000832568 by goroutine 7", race.Accesses[1].Description)
+	assertions.Len(race.Accesses[1].Frames, 4)
+	assertions.True(race.Accesses[1].Frames[3].Runtime)
+
+	assertions.Len(race.Goroutines, 2)
+	assertions.Equal(8, race.Goroutines[0].ID)
+	assertions.Equal("running", race.Goroutines[0].State)
+	assertions.Len(race.Goroutines[0].Frames, 1)
+	assertions.Equal(7, race.Goroutines[1].ID)
+	assertions.Equal("finished", race.Goroutines[1].State)
+	assertions.Equal(&stackFrame{Function: "main.main", File: "_testmain.go", Line: 50}, race.Goroutines[1].Frames[1])
+
+	assertions.Nil(parseRaces([]string{"=== RUN   TestFunc\n", "--- PASS: TestFunc (0.00s)\n"}, ""))
+}
+
+func TestReadRaces(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"example.com/module.TestRace": {
+			TestName: "TestRace",
+			Package:  "example.com/module",
+			Output:   raceOutput("TestRace", 8),
+		},
+		// the same race reported by another test, and a second time by the same test when it ran again
+		"example.com/module.TestRace2": {
+			TestName: "TestRace2",
+			Package:  "example.com/module",
+			Output:   append(raceOutput("TestRace2", 10), raceOutput("TestRace2", 12)...),
+		},
+		"example.com/module.TestOther": {
+			TestName: "TestOther",
+			Package:  "example.com/module",
+			Output: []string{
+				"WARNING: DATA RACE\n",
+				"Write at 0x00c000014108 by main goroutine:\n",
+				"  example.com/module.Other()\n",
+				"      /src/module/other.go:9 +0x44\n",
+				"==================\n",
+			},
+		},
+		"example.com/module.TestFunc": {TestName: "TestFunc", Package: "example.com/module", Passed: true},
+	}
+	races := readRaces(allTests, goListJSONByPackage{})
+	assertions.Len(races, 2)
+	assertions.Equal("race-1", races[0].ID)
+	assertions.Equal("Write at 0x00c000014108 by main goroutine", races[0].Accesses[0].Description)
+	assertions.Equal([]*raceTest{{TestName: "TestOther", Package: "example.com/module"}}, races[0].Tests)
+	assertions.Equal("race-2", races[1].ID)
+	assertions.Equal([]*raceTest{
+		{TestName: "TestRace", Package: "example.com/module"},
+		{TestName: "TestRace2", Package: "example.com/module"},
+	}, races[1].Tests)
+
+	assertions.Equal([]string{"race-1"}, allTests["example.com/module.TestOther"].Races)
+	assertions.Equal([]string{"race-2"}, allTests["example.com/module.TestRace"].Races)
+	assertions.Equal([]string{"race-2"}, allTests["example.com/module.TestRace2"].Races)
+	assertions.Nil(allTests["example.com/module.TestFunc"].Races)
+}
+
+func TestGenerateReportWithRaces(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"example.com/module.TestRace": {
+			TestName: "TestRace",
+			Package:  "example.com/module",
+			Output:   raceOutput("TestRace", 8),
+		},
+	}
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	tmplData.Races = readRaces(allTests, goListJSONByPackage{})
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, allTests, map[string]*packageStatus{}, testFileDetailsByPackage{}, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Contains(buffer.String(), `<span class="packagesTitle">Data Races:</span>`)
+	assertions.Contains(buffer.String(), `"Races":["race-1"]`)
+
+	buffer.Reset()
+	writer.Reset(buffer)
+	tmplData.Races = nil
+	assertions.Nil(generateReport(tmplData, map[string]*testStatus{}, map[string]*packageStatus{}, testFileDetailsByPackage{}, 0, writer))
+	assertions.Nil(writer.Flush())
+	assertions.NotContains(buffer.String(), "Data Races:")
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func initRunCommand(tmplData *templateData, flags *cmdFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "run [flags] [go test flags] [packages]",
+		Short: "Runs go test and parses its output into a single self-contained html file",
+		Long: `Runs "go test -json" with the given go test flags and packages, shows the results of the packages and the
+failed tests while the tests are running, and parses the output into a single self-contained html file.
+Exits with the exit code of go test if the tests failed.
+
+The arguments that are not flags of go-test-report are passed to go test, e.g.:
+
+  go-test-report run -o report.html -race -count=1 ./...
+
+Arguments following "--" are always passed to go test, which is needed for the go test flags sharing a shorthand
+with the flags of go-test-report, e.g.:
+
+  go-test-report run -o report.html -- -v ./...`,
+		// the go test flags are mixed with the flags of go-test-report, which are parsed by splitRunArgs
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startTime := time.Now()
+			ownArgs, goTestArgs, err := splitRunArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			if err := cmd.Flags().Parse(ownArgs); err != nil {
+				return err
+			}
+			if help, _ := cmd.Flags().GetBool("help"); help {
+				return cmd.Help()
+			}
+			if err := initTemplateData(tmplData, flags); err != nil {
+				return err
+			}
+			goTestArgs = append([]string{"test", "-json"}, goTestArgs...)
+			tmplData.TestCommand = "go " + strings.Join(goTestArgs, " ")
+			tmplData.testStartTime = startTime
+			flags.progress = true
+
+			goTestCmd := exec.Command("go", goTestArgs...)
+			goTestCmd.Stderr = cmd.ErrOrStderr()
+			stdout, err := goTestCmd.StdoutPipe()
+			if err != nil {
+				return err
+			}
+			if err := goTestCmd.Start(); err != nil {
+				return err
+			}
+			if err := generateReports(cmd, tmplData, flags, stdout, nil, startTime); err != nil {
+				_ = goTestCmd.Process.Kill()
+				_ = goTestCmd.Wait()
+				return err
+			}
+			// the report is complete, showing the usage would only hide the reason of a failure
+			cmd.SilenceUsage = true
+			if err := goTestCmd.Wait(); err != nil {
+				var exitErr *exec.ExitError
+				if errors.As(err, &exitErr) {
+					return &exitCodeError{
+						code: exitErr.ExitCode(),
+						err:  fmt.Errorf("ERROR: go test exited with status %d", exitErr.ExitCode()),
+					}
+				}
+				return err
+			}
+			return checkFailOnPolicies(tmplData, flags.failOnFlag)
+		},
+	}
+}
+
+// the single letter flags of go test, which are taken as the flags of go-test-report sharing their shorthand
+var goTestShorthands = map[string]bool{"a": true, "c": true, "i": true, "n": true, "o": true, "p": true, "v": true, "x": true}
+
+// splitRunArgs separates the flags of go-test-report from the arguments passed to go test: the arguments following
+// "--", and the arguments preceding it that are not flags of go-test-report, e.g. "-count=1" or "./...". The flags
+// of go-test-report are recognized by their name (e.g. "--output") or their shorthand (e.g. "-o"), the value of a
+// flag is either part of the argument ("--output=report.html") or the next argument. A warning is printed for the
+// shorthands that are also flags of go test, e.g. "-v", since they are easily meant for go test.
+func splitRunArgs(cmd *cobra.Command, args []string) (ownArgs []string, goTestArgs []string, err error) {
+	// the flags of the run command include the persistent flags of the root command
+	cmd.Flags().AddFlagSet(cmd.InheritedFlags())
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return ownArgs, append(goTestArgs, args[i+1:]...), nil
+		}
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		flag := cmd.Flags().Lookup(name)
+		if !strings.HasPrefix(arg, "--") {
+			flag = nil
+			if strings.HasPrefix(arg, "-") && len(name) == 1 {
+				flag = cmd.Flags().ShorthandLookup(name)
+			}
+		}
+		if flag == nil {
+			goTestArgs = append(goTestArgs, arg)
+			continue
+		}
+		if !strings.HasPrefix(arg, "--") && goTestShorthands[name] {
+			warningMsg := fmt.Sprintf("[go-test-report] WARNING: %s is taken as a flag of go-test-report, pass it after \"--\" to pass it to go test\n", arg)
+			if _, err := io.WriteString(cmd.ErrOrStderr(), warningMsg); err != nil {
+				return nil, nil, err
+			}
+		}
+		ownArgs = append(ownArgs, arg)
+		// bool flags have no value
+		if !strings.Contains(arg, "=") && flag.NoOptDefVal == "" && i+1 < len(args) {
+			i++
+			ownArgs = append(ownArgs, args[i])
+		}
+	}
+	return ownArgs, goTestArgs, nil
+}
+
+// progressWriter prints a readable version of the "go test -json" events while go test is running. Similar to the
+// output of go test without the -v flag, only the build errors, the output of failed tests and the results of the
+// packages are printed.
+type progressWriter struct {
+	writer io.Writer
+	// the output of the running tests by package and test name, printed if the test fails
+	testOutput map[string][]string
+}
+
+func (p *progressWriter) write(goTestOutputRow *goTestOutputRow) error {
+	if goTestOutputRow.Action == "build-output" || (goTestOutputRow.TestName == "" && goTestOutputRow.Action == "output") {
+		_, err := io.WriteString(p.writer, goTestOutputRow.Output)
+		return err
+	}
+	if goTestOutputRow.TestName == "" {
+		return nil
+	}
+	key := goTestOutputRow.Package + "." + goTestOutputRow.TestName
+	switch goTestOutputRow.Action {
+	case "output":
+		// "=== RUN", "=== PAUSE" and "=== CONT" are only printed by go test -v
+		if !strings.HasPrefix(goTestOutputRow.Output, "=== ") {
+			p.testOutput[key] = append(p.testOutput[key], goTestOutputRow.Output)
+		}
+	case "fail":
+		_, err := io.WriteString(p.writer, strings.Join(p.testOutput[key], ""))
+		delete(p.testOutput, key)
+		return err
+	case "pass", "skip":
+		delete(p.testOutput, key)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCommand(t *testing.T) {
+	assertions := assert.New(t)
+	moduleDir, err := ioutil.TempDir("", "go-test-report-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(moduleDir)
+	files := map[string]string{
+		"go.mod": "module sample\n\ngo 1.13\n",
+		"sample_test.go": `package sample
+
+import "testing"
+
+func TestPass(t *testing.T) {}
+
+func TestFail(t *testing.T) {
+	t.Error("sample failure")
+}
+`,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(moduleDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(moduleDir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(workingDir)
+
+	// the go test flags either follow "--" or are mixed with the flags of go-test-report
+	for _, args := range [][]string{
+		{"run", "-o", "report.html", "--", "-count=1", "."},
+		{"run", "-count=1", "--output=report.html", "."},
+	} {
+		assertions.Nil(os.RemoveAll(filepath.Join(moduleDir, "report.html")))
+		buffer := bytes.NewBufferString("")
+		rootCmd, tmplData, _ := initRootCommand()
+		rootCmd.SetOut(buffer)
+		// go test writes its stderr into the error buffer concurrently
+		rootCmd.SetErr(bytes.NewBufferString(""))
+		rootCmd.SetArgs(args)
+		rootCmdErr := rootCmd.Execute()
+
+		var exitErr *exitCodeError
+		assertions.True(errors.As(rootCmdErr, &exitErr))
+		assertions.Equal(1, exitErr.code)
+		assertions.Equal("ERROR: go test exited with status 1", rootCmdErr.Error())
+		assertions.Equal("go test -json -count=1 .", tmplData.TestCommand)
+		assertions.Equal(1, tmplData.NumOfTestPassed)
+		assertions.Equal(1, tmplData.NumOfTestFailed)
+		assertions.Contains(buffer.String(), "sample_test.go:8: sample failure\n--- FAIL: TestFail")
+		assertions.Contains(buffer.String(), "FAIL\tsample")
+		assertions.NotContains(buffer.String(), "--- PASS: TestPass")
+		assertions.FileExists(filepath.Join(moduleDir, "report.html"))
+	}
+}
+
+func TestSplitRunArgs(t *testing.T) {
+	assertions := assert.New(t)
+	rootCmd, _, _ := initRootCommand()
+	runCmd, _, err := rootCmd.Find([]string{"run"})
+	assertions.Nil(err)
+	stderr := &bytes.Buffer{}
+	runCmd.SetErr(stderr)
+	ownArgs, goTestArgs, err := splitRunArgs(runCmd, []string{
+		"-o", "report.html", "-race", "--title=Sample", "-timeout=5m", "-run", "TestParse", "-v", "--fail-on", "failures", "-s", "1200", "./...",
+	})
+	assertions.Nil(err)
+	assertions.Equal([]string{"-o", "report.html", "--title=Sample", "-v", "--fail-on", "failures", "-s", "1200"}, ownArgs)
+	assertions.Equal([]string{"-race", "-timeout=5m", "-run", "TestParse", "./..."}, goTestArgs)
+	// -o and -v are also flags of go test, -s is not
+	assertions.Equal(`[go-test-report] WARNING: -o is taken as a flag of go-test-report, pass it after "--" to pass it to go test
+[go-test-report] WARNING: -v is taken as a flag of go-test-report, pass it after "--" to pass it to go test
+`, stderr.String())
+
+	// the arguments following "--" are passed to go test, even if they are flags of go-test-report
+	stderr.Reset()
+	ownArgs, goTestArgs, err = splitRunArgs(runCmd, []string{"--verbose", "--output=report.html", "--", "-v", "-count=1", "./..."})
+	assertions.Nil(err)
+	assertions.Equal([]string{"--verbose", "--output=report.html"}, ownArgs)
+	assertions.Equal([]string{"-v", "-count=1", "./..."}, goTestArgs)
+	assertions.Empty(stderr.String())
+}
+
+func TestProgressWriter(t *testing.T) {
+	assertions := assert.New(t)
+	buffer := &bytes.Buffer{}
+	progress := &progressWriter{writer: buffer, testOutput: map[string][]string{}}
+	rows := []*goTestOutputRow{
+		{Action: "build-output", ImportPath: "foo [foo.test]", Output: "./foo_test.go:7:2: undefined: bar\n"},
+		{Action: "run", Package: "foo", TestName: "TestFunc1"},
+		{Action: "output", Package: "foo", TestName: "TestFunc1", Output: "=== RUN   TestFunc1\n"},
+		{Action: "output", Package: "foo", TestName: "TestFunc1", Output: "--- PASS: TestFunc1 (0.00s)\n"},
+		{Action: "pass", Package: "foo", TestName: "TestFunc1"},
+		{Action: "run", Package: "foo", TestName: "TestFunc2"},
+		{Action: "output", Package: "foo", TestName: "TestFunc2", Output: "=== RUN   TestFunc2\n"},
+		{Action: "output", Package: "foo", TestName: "TestFunc2", Output: "    foo_test.go:12: expected 1, got 2\n"},
+		{Action: "output", Package: "foo", TestName: "TestFunc2", Output: "--- FAIL: TestFunc2 (0.00s)\n"},
+		{Action: "fail", Package: "foo", TestName: "TestFunc2"},
+		{Action: "output", Package: "foo", Output: "FAIL\tfoo\t0.012s\n"},
+	}
+	for _, row := range rows {
+		assertions.Nil(progress.write(row))
+	}
+	assertions.Equal("./foo_test.go:7:2: undefined: bar\n    foo_test.go:12: expected 1, got 2\n--- FAIL: TestFunc2 (0.00s)\nFAIL\tfoo\t0.012s\n", buffer.String())
+	assertions.Empty(progress.testOutput)
+}
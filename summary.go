@@ -0,0 +1,88 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// the location prefix of a line logged by t.Error, t.Fatal or t.Log, e.g. "    parser_test.go:42: expected 1, got 2"
+	testLogLineRegexp = regexp.MustCompile(`^(\s*)(\S+_test\.go:\d+):(.*)$`)
+	// the location prefix of a line logged from any go file, e.g. by a test helper in a non-test file or by the
+	// race detector ("testing.go:1465: race detected during execution of test")
+	goLogLineRegexp  = regexp.MustCompile(`^(\s*)(\S+\.go:\d+):(.*)$`)
+	whitespaceRegexp = regexp.MustCompile(`\s+`)
+)
+
+// readFailureSummaries adds a one line summary of the failure to every failed test, see failureSummary. The panics
+// of the tests must be read first.
+func readFailureSummaries(allTests map[string]*testStatus) {
+	for _, status := range allTests {
+		status.FailureSummary = ""
+		if status.Passed || status.Skipped || status.Incomplete {
+			continue
+		}
+		status.FailureSummary = failureSummary(status)
+	}
+}
+
+// failureSummary returns the first line logged by the test with its location, e.g. "parser_test.go:42: expected 1,
+// got 2", or the first line of the value of its panic if it did not log anything. A line logged from a file other than
+// a test file, e.g. by a helper or by the race detector, is only used if the test did not log a line from a test file.
+// A message continued on the following lines, e.g. the "Error Trace:" and "Error:" lines of a testify assertion, is
+// summarized by its "Error:" line, or by its first line if it has none. An empty string is returned if the failure can
+// not be summarized, e.g. for a test that only failed because one of its subtests failed.
+func failureSummary(status *testStatus) string {
+	lines := strings.Split(strings.Join(status.Output, ""), "\n")
+	for _, logLineRegexp := range []*regexp.Regexp{testLogLineRegexp, goLogLineRegexp} {
+		if summary := logLineSummary(lines, logLineRegexp); summary != "" {
+			return summary
+		}
+	}
+	if status.Panic != nil {
+		// e.g. the value of the panic of a test that timed out continues with the running tests
+		return "panic: " + strings.SplitN(status.Panic.Value, "\n", 2)[0]
+	}
+	return ""
+}
+
+// logLineSummary returns the summary of the first line of the output matching the location prefix of a logged line,
+// an empty string if no line matches.
+func logLineSummary(lines []string, logLineRegexp *regexp.Regexp) string {
+	for i, line := range lines {
+		match := logLineRegexp.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			continue
+		}
+		message := strings.TrimSpace(match[3])
+		if message == "" {
+			message = continuedMessage(lines[i+1:], len(match[1]))
+		}
+		if message == "" {
+			return match[2]
+		}
+		return match[2] + ": " + message
+	}
+	return ""
+}
+
+// continuedMessage returns the summary of a message continued on the lines following its location, the lines of
+// the message are indented deeper than its first line.
+func continuedMessage(lines []string, indent int) string {
+	message := ""
+	for _, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		text := strings.TrimSpace(line)
+		if len(line)-len(strings.TrimLeft(line, " \t")) <= indent || text == "" {
+			break
+		}
+		text = whitespaceRegexp.ReplaceAllString(text, " ")
+		if strings.HasPrefix(text, "Error: ") {
+			return strings.TrimPrefix(text, "Error: ")
+		}
+		if message == "" {
+			message = text
+		}
+	}
+	return message
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureSummary(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Equal("parser_test.go:42: expected 1, got 2", failureSummary(&testStatus{Output: []string{
+		"=== RUN   TestParse\n",
+		"    parser_test.go:42: expected 1, got 2\n",
+		"    parser_test.go:43: expected 3, got 4\n",
+		"--- FAIL: TestParse (0.00s)\n",
+	}}))
+	// a message continued on the next lines is summarized by its first line
+	assertions.Equal("parser_test.go:42: unexpected result:", failureSummary(&testStatus{Output: []string{
+		"    parser_test.go:42: unexpected result:\n",
+		"        got:  1\n",
+	}}))
+	assertions.Equal("parser_test.go:12: Not equal:", failureSummary(&testStatus{Output: []string{
+		"=== RUN   TestParse/case\n",
+		"        parser_test.go:12: \n",
+		"            \tError Trace:\tparser_test.go:12\n",
+		"            \tError:      \tNot equal: \n",
+		"            \t            \texpected: 1\n",
+		"            \t            \tactual  : 2\n",
+		"            \tTest:       \tTestParse/case\n",
+		"    --- FAIL: TestParse/case (0.00s)\n",
+	}}))
+	assertions.Equal("parser_test.go:12: got 1", failureSummary(&testStatus{Output: []string{
+		"    parser_test.go:12: \n",
+		"        got 1\n",
+	}}))
+	assertions.Equal("parser_test.go:12", failureSummary(&testStatus{Output: []string{
+		"    parser_test.go:12: \n",
+		"--- FAIL: TestParse (0.00s)\n",
+	}}))
+	// a test failing from the race detector or from a helper in a non-test file is summarized by the line logged
+	// by the testing package or by the helper, the frames of a stack are not logged lines
+	assertions.Equal("testing.go:1865: race detected during execution of test", failureSummary(&testStatus{Output: []string{
+		"      /src/module/module_test.go:6 +0x1c\n",
+		"    testing.go:1865: race detected during execution of test\n",
+	}}))
+	assertions.Equal("testutil.go:17: unexpected status 500", failureSummary(&testStatus{Output: []string{
+		"=== RUN   TestServer\n",
+		"    testutil.go:17: unexpected status 500\n",
+		"--- FAIL: TestServer (0.00s)\n",
+	}}))
+	// a line logged from a test file is preferred
+	assertions.Equal("server_test.go:30: expected 200", failureSummary(&testStatus{Output: []string{
+		"    testutil.go:17: request sent\n",
+		"    server_test.go:30: expected 200\n",
+	}}))
+	assertions.Equal("", failureSummary(&testStatus{Output: []string{
+		"      /src/module/module_test.go:6 +0x1c\n",
+	}}))
+	assertions.Equal("panic: boom", failureSummary(&testStatus{
+		Output: []string{"panic: boom\n", "\n", "goroutine 7 [running]:\n"},
+		Panic:  &panicDetail{Value: "boom"},
+	}))
+	assertions.Equal("panic: test timed out after 1s", failureSummary(&testStatus{
+		Output: []string{"panic: test timed out after 1s\n", "\trunning tests:\n", "\t\tTestSlow (1s)\n"},
+		Panic:  &panicDetail{Value: "test timed out after 1s\n\trunning tests:\n\t\tTestSlow (1s)\n\t\tTestSlow/sub (1s)"},
+	}))
+}
+
+func TestReadFailureSummaries(t *testing.T) {
+	assertions := assert.New(t)
+	output := []string{"    foo_test.go:10: expected 1, got 2\n"}
+	allTests := map[string]*testStatus{
+		"foo.TestFailed":     {TestName: "TestFailed", Output: output},
+		"foo.TestPassed":     {TestName: "TestPassed", Output: output, Passed: true},
+		"foo.TestSkipped":    {TestName: "TestSkipped", Output: output, Skipped: true},
+		"foo.TestIncomplete": {TestName: "TestIncomplete", Output: output, Incomplete: true},
+	}
+	readFailureSummaries(allTests)
+	assertions.Equal("foo_test.go:10: expected 1, got 2", allTests["foo.TestFailed"].FailureSummary)
+	assertions.Equal("", allTests["foo.TestPassed"].FailureSummary)
+	assertions.Equal("", allTests["foo.TestSkipped"].FailureSummary)
+	assertions.Equal("", allTests["foo.TestIncomplete"].FailureSummary)
+}
@@ -2,18 +2,130 @@
  * @typedef TestStatus
  * @property {string} TestName
  * @property {string} Package
+ * @property {string} Source
  * @property {number} ElapsedTime
  * @property {Array.<string>} Output
  * @property {boolean} Passed
  * @property {boolean} Skipped
+ * @property {boolean} Flaky
+ * @property {boolean} TimedOut
+ * @property {boolean} Incomplete
+ * @property {Array.<TestAttempt>} Attempts
+ * @property {boolean} Fuzz
+ * @property {FuzzFailure|null} FuzzFailure
+ * @property {PanicDetail|null} Panic
+ * @property {Array.<string>|null} Races
+ * @property {string} FailureSummary
+ * @property {Array.<TestifyFailure>|null} TestifyFailures
+ * @property {Array.<TestStatus>} Subtests
+ * @property {number} NumOfSubtestsPassed
+ * @property {number} NumOfSubtestsFailed
+ * @property {number} NumOfSubtestsSkipped
+ * @property {number} NumOfSubtestsIncomplete
  */
 class TestStatus {}
 
+/**
+ * @typedef TestAttempt
+ * @property {number} ElapsedTime
+ * @property {Array.<string>} Output
+ * @property {boolean} Passed
+ * @property {boolean} Skipped
+ * @property {boolean} Incomplete
+ */
+class TestAttempt {}
+
+/**
+ * @typedef FuzzFailure
+ * @property {string} InputFile
+ * @property {string} Input
+ * @property {string} ReproCommand
+ */
+class FuzzFailure {}
+
+/**
+ * @typedef StackFrame
+ * @property {string} Function
+ * @property {string} File
+ * @property {number} Line
+ * @property {boolean} CreatedBy
+ * @property {boolean} InModule
+ * @property {boolean} Runtime
+ */
+class StackFrame {}
+
+/**
+ * @typedef GoroutineStack
+ * @property {number} ID
+ * @property {string} State
+ * @property {Array.<StackFrame>} Frames
+ */
+class GoroutineStack {}
+
+/**
+ * @typedef PanicDetail
+ * @property {string} Value
+ * @property {Array.<GoroutineStack>} Goroutines
+ */
+class PanicDetail {}
+
+/**
+ * @typedef RaceAccess
+ * @property {string} Description
+ * @property {Array.<StackFrame>} Frames
+ */
+class RaceAccess {}
+
+/**
+ * @typedef RaceTest
+ * @property {string} TestName
+ * @property {string} Package
+ */
+class RaceTest {}
+
+/**
+ * @typedef DataRace
+ * @property {string} ID
+ * @property {Array.<RaceAccess>} Accesses
+ * @property {Array.<GoroutineStack>} Goroutines
+ * @property {string} Location
+ * @property {Array.<RaceTest>} Tests
+ */
+class DataRace {}
+
+/**
+ * @typedef TraceLocation
+ * @property {string} File
+ * @property {number} Line
+ */
+class TraceLocation {}
+
+/**
+ * @typedef DiffLine
+ * @property {string} Kind
+ * @property {string} Text
+ */
+class DiffLine {}
+
+/**
+ * @typedef TestifyFailure
+ * @property {string} Location
+ * @property {Array.<TraceLocation>|null} ErrorTrace
+ * @property {string} Error
+ * @property {string} ErrorDetail
+ * @property {string} Expected
+ * @property {string} Actual
+ * @property {Array.<DiffLine>|null} Diff
+ * @property {string} Messages
+ */
+class TestifyFailure {}
+
 /**
  * @typedef TestGroupData
  * @type {object}
  * @property {string} FailureIndicator
  * @property {string} SkippedIndicator
+ * @property {string} IncompleteIndicator
  * @property {Array.<TestStatus>}
  */
 class TestGroupData {}
@@ -36,6 +148,10 @@ class SelectedItems {}
  * @property {TestResults} data
  * @property {HTMLElement} testResultsElem
  * @property {HTMLElement} testGroupListElem
+ * @property {HTMLElement|null} benchmarkTableElem
+ * @property {HTMLElement|null} packageListElem
+ * @property {Array.<DataRace>|null} races
+ * @property {HTMLElement|null} raceListElem
  */
 class GoTestReportElements {}
 
@@ -43,7 +159,7 @@ class GoTestReportElements {}
 /**
  * Main entry point for GoTestReport.
  * @param {GoTestReportElements} elements
- * @returns {{testResultsClickHandler: testResultsClickHandler}}
+ * @returns {{testResultsClickHandler: testResultsClickHandler, testGroupListHandler: testGroupListHandler, subtestToggleHandler: subtestToggleHandler, attemptTabHandler: attemptTabHandler, rawOutputToggleHandler: rawOutputToggleHandler, benchmarkTableHandler: benchmarkTableHandler, coverageLinkHandler: coverageLinkHandler}}
  * @constructor
  */
 window.GoTestReport = function (elements) {
@@ -59,6 +175,455 @@ window.GoTestReport = function (elements) {
     return event
   }
 
+  /**
+   * Returns the test status referenced by a test group row. Rows of subtests carry a "data-subindex" attribute
+   * containing the dot-separated path of indexes into the Subtests of the top level test.
+   * @param {Element} target
+   * @param {TestResults} data
+   * @returns {TestStatus}
+   */
+  function getTestStatus(target, data) {
+    const attribs = target['attributes']
+    const groupId = /**@type {number}*/ attribs['data-groupid'].value
+    const testIndex = /**@type {number}*/ attribs['data-index'].value
+    let testStatus = /**@type {TestStatus}*/ data[groupId]['TestResults'][testIndex]
+    if (attribs.hasOwnProperty('data-subindex')) {
+      attribs['data-subindex'].value
+                              .split('.')
+                              .forEach((subIndex) => testStatus = testStatus.Subtests[subIndex])
+    }
+    return testStatus
+  }
+
+  /**
+   * Colors the output console using the status of a test or of one of its attempts.
+   * @param {HTMLElement} consolePre
+   * @param {TestStatus|TestAttempt} status
+   */
+  function setConsoleStatus(consolePre, status) {
+    if (status.Passed) {
+      consolePre.classList.remove('skipped')
+      consolePre.classList.remove('failed')
+    } else if (status.Skipped) {
+      consolePre.classList.add('skipped')
+      consolePre.classList.remove('failed')
+    } else {
+      consolePre.classList.remove('skipped')
+      consolePre.classList.add('failed')
+    }
+  }
+
+  /**
+   * Returns the markup of the tabs used to switch between the output of the attempts of a test that ran more than
+   * once. The first tab shows the output of all attempts.
+   * @param {TestStatus} testStatus
+   * @returns {string}
+   */
+  function attemptTabsHTML(testStatus) {
+    let tabs = /**@type {string}*/ `<span class="attemptTab selected" data-attempt="">All attempts</span>`
+    testStatus.Attempts.forEach((attempt, i) => {
+      let attemptStatus = /**@type {string}*/ (attempt.Passed) ? 'passed' : (attempt.Skipped ? 'skipped' : 'failed')
+      let attemptIcon = /**@type {string}*/ (attempt.Passed) ? '&check;' : (attempt.Skipped ? '&dash;' : '&cross;')
+      if (attempt.Incomplete) {
+        attemptStatus = 'incomplete'
+        attemptIcon = '?'
+      }
+      tabs += `<span class="attemptTab ${attemptStatus}" data-attempt="${i}">#${i + 1} ${attemptIcon} ${attempt.ElapsedTime}s</span>`
+    })
+    return tabs
+  }
+
+  /**
+   * Escapes the characters of a text that have a special meaning in HTML.
+   * @param {string} text
+   * @returns {string}
+   */
+  function escapeHTML(text) {
+    return text.replace(/&/g, '&amp;')
+               .replace(/</g, '&lt;')
+               .replace(/>/g, '&gt;')
+               .replace(/"/g, '&quot;')
+  }
+
+  /**
+   * Returns the escaped markup of a text in which the part that differs from another text is marked, i.e. the text
+   * between the prefix and the suffix the two texts have in common.
+   * @param {string} text
+   * @param {string} other
+   * @returns {string}
+   */
+  function markDifference(text, other) {
+    const maxLength = /**@type {number}*/ Math.min(text.length, other.length)
+    let prefix = /**@type {number}*/ 0
+    while (prefix < maxLength && text[prefix] === other[prefix]) {
+      prefix++
+    }
+    let suffix = /**@type {number}*/ 0
+    while (suffix < maxLength - prefix && text[text.length - 1 - suffix] === other[other.length - 1 - suffix]) {
+      suffix++
+    }
+    if (prefix + suffix === text.length) {
+      return escapeHTML(text)
+    }
+    return escapeHTML(text.substring(0, prefix)) +
+           `<mark>${escapeHTML(text.substring(prefix, text.length - suffix))}</mark>` +
+           escapeHTML(text.substring(text.length - suffix))
+  }
+
+  /**
+   * Returns the number of whitespace characters a line of output is indented by.
+   * @param {string} line
+   * @returns {number}
+   */
+  function indentation(line) {
+    return line.length - line.trimStart().length
+  }
+
+  // e.g. "foo_test.go:42: mismatch (-want +got):"
+  const cmpDiffHeaderRegExp = /[(\s]-\w+ \+\w+\)?:?\s*$/
+  // e.g. "  - \tName: "foo"," in the output of cmp.Diff, which randomly uses a non-breaking space after the marker
+  const cmpDiffLineRegExp = /^\s*([-+])[\s\u00a0]/
+  // e.g. "foo_test.go:42: got 1, want 2" or "foo_test.go:42: want "a" but got "b""
+  const gotWantRegExp = /^(.*?\b(got|want)\b:?\s+)(.+?)(,?\s+(?:but\s+)?(got|want)\b:?\s+)(.+?)(\s*)$/
+  // e.g. "        got:  1" followed by "        want: 2"
+  const gotWantLineRegExp = /^(\s*(got|want):\s*)(.*?)(\s*)$/
+
+  /**
+   * Returns the markup of the lines of a cmp.Diff block. The removed and the added lines are colored, the differences
+   * of a removed line and the added line replacing it are marked.
+   * @param {Array.<string>} lines
+   * @returns {string}
+   */
+  function cmpDiffHTML(lines) {
+    const markers = /**@type {Array.<string>}*/ lines.map((line) => {
+      const match = line.match(cmpDiffLineRegExp)
+      return (match == null) ? '' : match[1]
+    })
+    // a run of removed lines followed by a run of added lines replaces the removed lines with the added lines, the
+    // lines at the same position of the two runs are paired
+    const pairs = /**@type {Array.<number>}*/ lines.map(() => -1)
+    for (let i = 0; i < lines.length;) {
+      if (markers[i] !== '-') {
+        i++
+        continue
+      }
+      let removedEnd = i
+      while (removedEnd < lines.length && markers[removedEnd] === '-') {
+        removedEnd++
+      }
+      let addedEnd = removedEnd
+      while (addedEnd < lines.length && markers[addedEnd] === '+') {
+        addedEnd++
+      }
+      for (let j = 0; j < Math.min(removedEnd - i, addedEnd - removedEnd); j++) {
+        pairs[i + j] = removedEnd + j
+        pairs[removedEnd + j] = i + j
+      }
+      i = addedEnd
+    }
+    // the text of a line following its marker
+    const markedText = (i) => lines[i].substring(lines[i].indexOf(markers[i]) + 1)
+    return lines.map((line, i) => {
+      if (markers[i] === '') {
+        return escapeHTML(line)
+      }
+      const kind = /**@type {string}*/ (markers[i] === '-') ? 'removed' : 'added'
+      const marker = /**@type {string}*/ line.substring(0, line.indexOf(markers[i]) + 1)
+      const textHTML = /**@type {string}*/ (pairs[i] < 0) ? escapeHTML(markedText(i)) : markDifference(markedText(i), markedText(pairs[i]))
+      return `<span class="diffLine ${kind}">${escapeHTML(marker)}${textHTML}</span>`
+    }).join('\n')
+  }
+
+  /**
+   * Returns the markup of a got or a want value, the part of the value that differs from the other value is marked.
+   * @param {string} keyword Either "got" or "want".
+   * @param {string} value
+   * @param {string} other
+   * @returns {string}
+   */
+  function gotWantValueHTML(keyword, value, other) {
+    return `<span class="diffValue ${keyword}">${markDifference(value, other)}</span>`
+  }
+
+  /**
+   * Returns the markup of the output of a test in which the diffs of the failure messages are highlighted: the
+   * blocks printed with go-cmp's cmp.Diff, introduced by a line ending with e.g. "(-want +got):", and the got and
+   * want values of messages like "got 1, want 2" or of consecutive "got:" and "want:" lines. Returns null if the
+   * output contains no diffs, so that it is shown as is.
+   * @param {Array.<string>} output
+   * @returns {string|null}
+   */
+  function outputDiffsHTML(output) {
+    const lines = /**@type {Array.<string>}*/ output.join('').split('\n')
+    const linesHTML = /**@type {Array.<string>}*/ []
+    let numOfDiffs = /**@type {number}*/ 0
+    for (let i = 0; i < lines.length; i++) {
+      const line = /**@type {string}*/ lines[i]
+      if (cmpDiffHeaderRegExp.test(line)) {
+        // the lines of the diff are indented deeper than the message introducing it
+        let end = i + 1
+        while (end < lines.length && lines[end].trim() !== '' && indentation(lines[end]) > indentation(line)) {
+          end++
+        }
+        const diffLines = /**@type {Array.<string>}*/ lines.slice(i + 1, end)
+        if (diffLines.some((diffLine) => cmpDiffLineRegExp.test(diffLine))) {
+          linesHTML.push(escapeHTML(line), cmpDiffHTML(diffLines))
+          numOfDiffs++
+          i = end - 1
+          continue
+        }
+      }
+      const match = line.match(gotWantRegExp)
+      if (match != null && match[2] !== match[5]) {
+        linesHTML.push(escapeHTML(match[1]) + gotWantValueHTML(match[2], match[3], match[6]) +
+                       escapeHTML(match[4]) + gotWantValueHTML(match[5], match[6], match[3]) + escapeHTML(match[7]))
+        numOfDiffs++
+        continue
+      }
+      const lineMatch = line.match(gotWantLineRegExp)
+      const nextMatch = (lineMatch == null || i + 1 >= lines.length) ? null : lines[i + 1].match(gotWantLineRegExp)
+      if (nextMatch != null && lineMatch[2] !== nextMatch[2]) {
+        linesHTML.push(escapeHTML(lineMatch[1]) + gotWantValueHTML(lineMatch[2], lineMatch[3], nextMatch[3]) + escapeHTML(lineMatch[4]),
+                       escapeHTML(nextMatch[1]) + gotWantValueHTML(nextMatch[2], nextMatch[3], lineMatch[3]) + escapeHTML(nextMatch[4]))
+        numOfDiffs++
+        i++
+        continue
+      }
+      linesHTML.push(escapeHTML(line))
+    }
+    return (numOfDiffs > 0) ? linesHTML.join('\n') : null
+  }
+
+  /**
+   * Shows the output of a test, or of one of its attempts, in the output console. The diffs of the failure messages
+   * are highlighted unless the raw output was selected with the toggle of the console.
+   * @param {HTMLElement} consolePre
+   * @param {Array.<string>} output
+   */
+  function setConsoleOutput(consolePre, output) {
+    const diffsHTML = /**@type {string|null}*/ consolePre.classList.contains('raw') ? null : outputDiffsHTML(output)
+    if (diffsHTML == null) {
+      consolePre.textContent = output.join('')
+    } else {
+      consolePre.innerHTML = diffsHTML
+    }
+  }
+
+  /**
+   * Returns the markup of the frames of a goroutine stack. Frames of the module under test are highlighted and
+   * consecutive frames of the runtime and testing packages are collapsed.
+   * @param {Array.<StackFrame>} frames
+   * @returns {string}
+   */
+  function stackFramesHTML(frames) {
+    let framesHTML = /**@type {string}*/ ''
+    let runtimeFrames = /**@type {string}*/ ''
+    let numOfRuntimeFrames = /**@type {number}*/ 0
+    const flushRuntimeFrames = () => {
+      if (numOfRuntimeFrames > 0) {
+        framesHTML += `<details class="runtimeFrames"><summary>${numOfRuntimeFrames} runtime/testing frame(s)</summary>${runtimeFrames}</details>`
+      }
+      runtimeFrames = ''
+      numOfRuntimeFrames = 0
+    }
+    frames.forEach((frame) => {
+      const frameHTML = /**@type {string}*/ `<div class="stackFrame${frame.InModule ? ' inModule' : ''}">
+          <span class="function">${frame.CreatedBy ? 'created by ' : ''}${escapeHTML(frame.Function)}</span>
+          <span class="location">${escapeHTML(frame.File)}:${frame.Line}</span>
+        </div>`
+      if (frame.Runtime) {
+        runtimeFrames += frameHTML
+        numOfRuntimeFrames++
+      } else {
+        flushRuntimeFrames()
+        framesHTML += frameHTML
+      }
+    })
+    flushRuntimeFrames()
+    return framesHTML
+  }
+
+  /**
+   * Returns an element showing the value of a panic and the stacks of the goroutines. Only the stack of the first
+   * goroutine, the one that panicked, is expanded.
+   * @param {PanicDetail} panicDetail
+   * @returns {HTMLDivElement}
+   */
+  function panicElement(panicDetail) {
+    const panicDiv = document.createElement('div')
+    panicDiv.classList.add('panic')
+    let panicHTML = /**@type {string}*/ `<div class="panicValue"><strong>panic:</strong> ${escapeHTML(panicDetail.Value)}</div>`
+    const goroutines = /**@type {Array.<GoroutineStack>}*/ (panicDetail.Goroutines == null) ? [] : panicDetail.Goroutines
+    goroutines.forEach((goroutine, i) => {
+      panicHTML += `<details class="goroutine"${(i === 0) ? ' open' : ''}>
+          <summary>goroutine ${goroutine.ID} [${escapeHTML(goroutine.State)}]</summary>
+          ${stackFramesHTML((goroutine.Frames == null) ? [] : goroutine.Frames)}
+        </details>`
+    })
+    panicDiv.innerHTML = panicHTML
+    return panicDiv
+  }
+
+  /**
+   * Returns the markup of a location of an error trace. Absolute paths link to the file, which opens the source file
+   * when the report is viewed on the machine that ran the tests.
+   * @param {TraceLocation} location
+   * @returns {string}
+   */
+  function traceLocationHTML(location) {
+    const text = /**@type {string}*/ `${escapeHTML(location.File)}:${location.Line}`
+    if (!location.File.startsWith('/')) {
+      return `<span class="traceLocation">${text}</span>`
+    }
+    return `<a class="traceLocation" href="file://${escapeHTML(encodeURI(location.File))}">${text}</a>`
+  }
+
+  /**
+   * Returns an element showing the failed testify assertions of a test. The expected and the actual value are shown
+   * side by side, followed by the colored unified diff printed by testify.
+   * @param {Array.<TestifyFailure>} failures
+   * @returns {HTMLDivElement}
+   */
+  function testifyFailuresElement(failures) {
+    const failuresDiv = document.createElement('div')
+    failuresDiv.classList.add('testifyFailures')
+    let failuresHTML = /**@type {string}*/ ''
+    failures.forEach((failure) => {
+      const errorTrace = /**@type {Array.<TraceLocation>}*/ (failure.ErrorTrace == null) ? [] : failure.ErrorTrace
+      const diff = /**@type {Array.<DiffLine>}*/ (failure.Diff == null) ? [] : failure.Diff
+      failuresHTML += `<div class="testifyFailure">
+          <div class="testifyError"><strong>${escapeHTML(failure.Location)}:</strong> ${escapeHTML(failure.Error)}</div>
+          ${(failure.Messages !== '') ? `<div class="testifyMessages"><strong>Messages:</strong> ${escapeHTML(failure.Messages)}</div>` : ''}
+          ${(failure.ErrorDetail !== '') ? `<pre class="testifyErrorDetail">${escapeHTML(failure.ErrorDetail)}</pre>` : ''}
+          ${(failure.Expected !== '' || failure.Actual !== '') ? `<div class="testifyValues">
+            <div class="expected"><strong>expected</strong><pre>${escapeHTML(failure.Expected)}</pre></div>
+            <div class="actual"><strong>actual</strong><pre>${escapeHTML(failure.Actual)}</pre></div>
+          </div>` : ''}
+          ${(diff.length > 0) ? `<pre class="diff">${diff.map((line) => `<span class="diffLine ${line.Kind}">${escapeHTML(line.Text)}</span>`).join('\n')}</pre>` : ''}
+          ${(errorTrace.length > 0) ? `<div class="errorTrace"><strong>Error Trace:</strong> ${errorTrace.map(traceLocationHTML).join(' ')}</div>` : ''}
+        </div>`
+    })
+    failuresDiv.innerHTML = failuresHTML
+    return failuresDiv
+  }
+
+  /**
+   * Returns an element showing the conflicting accesses of a data race, the goroutines of the accesses and the tests
+   * reporting the race. The stacks of the accesses are expanded, the stacks of the goroutine creation sites are not.
+   * @param {DataRace} race
+   * @returns {HTMLDivElement}
+   */
+  function raceElement(race) {
+    const raceDiv = document.createElement('div')
+    raceDiv.classList.add('dataRace')
+    raceDiv.id = race.ID
+    const tests = /**@type {Array.<RaceTest>}*/ (race.Tests == null) ? [] : race.Tests
+    let raceHTML = /**@type {string}*/ `<div class="raceTitle"><strong>Data race #${race.ID.replace('race-', '')}</strong>
+        ${(race.Location !== '') ? `<span class="raceLocation">${escapeHTML(race.Location)}</span>` : ''}</div>
+        <div class="raceTests"><strong>Reported by:</strong> ${tests.map((test) => `${escapeHTML(test.TestName)} (${escapeHTML(test.Package)})`).join(', ')}</div>`
+    const accesses = /**@type {Array.<RaceAccess>}*/ (race.Accesses == null) ? [] : race.Accesses
+    accesses.forEach((access) => {
+      raceHTML += `<details class="raceAccess" open>
+          <summary>${escapeHTML(access.Description)}</summary>
+          ${stackFramesHTML((access.Frames == null) ? [] : access.Frames)}
+        </details>`
+    })
+    const goroutines = /**@type {Array.<GoroutineStack>}*/ (race.Goroutines == null) ? [] : race.Goroutines
+    goroutines.forEach((goroutine) => {
+      raceHTML += `<details class="goroutine">
+          <summary>Goroutine ${goroutine.ID} (${escapeHTML(goroutine.State)}) created at</summary>
+          ${stackFramesHTML((goroutine.Frames == null) ? [] : goroutine.Frames)}
+        </details>`
+    })
+    raceDiv.innerHTML = raceHTML
+    return raceDiv
+  }
+
+  /**
+   * Returns an element linking to the data races reported in the output of a test.
+   * @param {Array.<string>} raceIDs
+   * @returns {HTMLDivElement}
+   */
+  function testRacesElement(raceIDs) {
+    const racesDiv = document.createElement('div')
+    racesDiv.classList.add('races')
+    racesDiv.innerHTML = `<strong>Data races:</strong> ${raceIDs.map((id) => `<a href="#${id}">#${id.replace('race-', '')}</a>`).join(' ')}`
+    return racesDiv
+  }
+
+  /**
+   * Returns an element showing the failing input of a fuzz test and the command reproducing the failure.
+   * @param {FuzzFailure} fuzzFailure
+   * @returns {HTMLDivElement}
+   */
+  function fuzzFailureElement(fuzzFailure) {
+    const fuzzFailureDiv = document.createElement('div')
+    fuzzFailureDiv.classList.add('fuzzFailure')
+    const inputFileDiv = document.createElement('div')
+    inputFileDiv.classList.add('inputFile')
+    inputFileDiv.innerHTML = `<strong>Failing input:</strong> `
+    inputFileDiv.insertAdjacentText('beforeend', (fuzzFailure.InputFile === '') ? 'seed corpus entry added with f.Add' : fuzzFailure.InputFile)
+    fuzzFailureDiv.insertAdjacentElement('beforeend', inputFileDiv)
+    if (fuzzFailure.Input !== '') {
+      const inputPre = document.createElement('pre')
+      inputPre.classList.add('input')
+      inputPre.textContent = fuzzFailure.Input
+      fuzzFailureDiv.insertAdjacentElement('beforeend', inputPre)
+    }
+    const reproCommandDiv = document.createElement('div')
+    reproCommandDiv.classList.add('reproCommand')
+    reproCommandDiv.innerHTML = `<strong>To re-run:</strong> `
+    const reproCommandCode = document.createElement('code')
+    reproCommandCode.textContent = fuzzFailure.ReproCommand
+    reproCommandDiv.insertAdjacentElement('beforeend', reproCommandCode)
+    fuzzFailureDiv.insertAdjacentElement('beforeend', reproCommandDiv)
+    return fuzzFailureDiv
+  }
+
+  /**
+   * Returns the markup of a single row in the test group list.
+   * @param {TestStatus} testResult
+   * @param {string} testId
+   * @param {number} index
+   * @param {string|null} subIndex
+   * @param {string} title The title of the row, escaped by this function since subtest names are free-form text.
+   * @returns {string}
+   */
+  function testGroupRowHTML(testResult, testId, index, subIndex, title) {
+    const testPassed = /**@type {boolean}*/ testResult.Passed
+    const testSkipped = /**@type {boolean}*/ testResult.Skipped
+    let testPassedStatus = /**@type {string}*/ (testPassed) ? '' : (testSkipped ? 'skipped' : 'failed')
+    let testStatusIcon = /**@type {string}*/ (testPassed) ? '&check;' : (testSkipped ? '&dash;' : '&cross;')
+    if (testResult.TimedOut) {
+      testPassedStatus = 'failed timedout'
+      testStatusIcon = '&#8987;'
+    } else if (testResult.Incomplete) {
+      testPassedStatus = 'incomplete'
+      testStatusIcon = '?'
+    }
+    const subIndexAttr = /**@type {string}*/ (subIndex == null) ? '' : ` data-subindex="${subIndex}"`
+    let subtestToggle = /**@type {string}*/ ''
+    let subtestSummary = /**@type {string}*/ ''
+    if (testResult.Subtests != null && testResult.Subtests.length > 0) {
+      subtestToggle = `<span class="subtestToggle">&#9656;</span>`
+      subtestSummary = `<span class="subtestSummary">
+          <span class="passed">&check; ${testResult.NumOfSubtestsPassed}</span>
+          <span class="skipped">&dash; ${testResult.NumOfSubtestsSkipped}</span>
+          <span class="failed">&cross; ${testResult.NumOfSubtestsFailed}</span>
+          ${(testResult.NumOfSubtestsIncomplete > 0) ? `<span class="incomplete">? ${testResult.NumOfSubtestsIncomplete}</span>` : ''}
+        </span>`
+    }
+    const flakyBadge = /**@type {string}*/ (testResult.Flaky) ? `<span class="flakyBadge">flaky</span>` : ''
+    const fuzzBadge = /**@type {string}*/ (testResult.Fuzz) ? `<span class="fuzzBadge">fuzz</span>` : ''
+    const raceBadge = /**@type {string}*/ (testResult.Races != null && testResult.Races.length > 0) ? `<span class="raceBadge">data race</span>` : ''
+    const failureSummary = /**@type {string}*/ (testResult.FailureSummary) ? `<span class="failureSummary">${escapeHTML(testResult.FailureSummary)}</span>` : ''
+    return `<div class="testGroupRow ${testPassedStatus}" data-groupid="${testId}" data-index="${index}"${subIndexAttr}>
+        <span class="testStatus ${testPassedStatus}">${testStatusIcon}</span>
+        ${subtestToggle}<span class="testTitle">${escapeHTML(title)}${flakyBadge}${fuzzBadge}${raceBadge}${subtestSummary}</span>${failureSummary}
+        <span class="testDuration"><span>${testResult.ElapsedTime}s </span>⏱</span>
+      </div>`
+  }
+
 
   const goTestReport = {
     /**
@@ -95,16 +660,9 @@ window.GoTestReport = function (elements) {
       selectedItems.testResults = target
       target.classList.add("selected")
       for (let i = 0; i < testResults.length; i++) {
-        const testResult = /**@type {TestGroupData}*/ testResults[i]
-        const testPassed = /**@type {boolean}*/ testResult.Passed
-        const testSkipped = /**@type {boolean}*/ testResult.Skipped
-        const testPassedStatus = /**@type {string}*/ (testPassed) ? '' : (testSkipped ? 'skipped' : 'failed')
+        const testResult = /**@type {TestStatus}*/ testResults[i]
         const testId = /**@type {string}*/ target.attributes['id'].value
-        testGroupList += `<div class="testGroupRow ${testPassedStatus}" data-groupid="${testId}" data-index="${i}">
-        <span class="testStatus ${testPassedStatus}">${(testPassed) ? '&check' : (testSkipped ? '&dash' : '&cross')};</span>
-        <span class="testTitle">${testResult.TestName}</span>
-        <span class="testDuration"><span>${testResult.ElapsedTime}s </span>⏱</span>
-      </div>`
+        testGroupList += testGroupRowHTML(testResult, testId, i, null, testResult.TestName)
       }
       const testGroupListElem = elements.testGroupListElem
       testGroupListElem.innerHTML = ''
@@ -126,9 +684,7 @@ window.GoTestReport = function (elements) {
     testGroupListHandler: function (target, data) {
       const attribs = target['attributes']
       if (attribs.hasOwnProperty('data-groupid')) {
-        const groupId = /**@type {number}*/ attribs['data-groupid'].value
-        const testIndex = /**@type {number}*/ attribs['data-index'].value
-        const testStatus = /**@type {TestStatus}*/ data[groupId]['TestResults'][testIndex]
+        const testStatus = /**@type {TestStatus}*/ getTestStatus(target, data)
         const testOutputDiv = /**@type {HTMLDivElement}*/ target.querySelector('div.testOutput')
 
         if (testOutputDiv == null) {
@@ -152,25 +708,159 @@ window.GoTestReport = function (elements) {
           }
           testDetailDiv.insertAdjacentElement('beforeend', packageNameDiv)
           testDetailDiv.insertAdjacentElement('beforeend', testFileNameDiv)
+          if (testStatus.Source != null && testStatus.Source !== '') {
+            const sourceDiv = document.createElement('div')
+            sourceDiv.classList.add('source')
+            sourceDiv.innerHTML = `<strong>Source:</strong> ${escapeHTML(testStatus.Source)}`
+            testDetailDiv.insertAdjacentElement('beforeend', sourceDiv)
+          }
           testOutputDiv.insertAdjacentElement('afterbegin', consolePre)
+          if (outputDiffsHTML(testStatus.Output) != null) {
+            const rawOutputToggle = document.createElement('span')
+            rawOutputToggle.classList.add('rawOutputToggle')
+            rawOutputToggle.textContent = 'Show raw output'
+            testOutputDiv.insertAdjacentElement('afterbegin', rawOutputToggle)
+          }
+          if (testStatus.Attempts != null && testStatus.Attempts.length > 1) {
+            const attemptTabsDiv = document.createElement('div')
+            attemptTabsDiv.classList.add('attemptTabs')
+            attemptTabsDiv.innerHTML = attemptTabsHTML(testStatus)
+            testOutputDiv.insertAdjacentElement('afterbegin', attemptTabsDiv)
+          }
+          if (testStatus.TestifyFailures != null && testStatus.TestifyFailures.length > 0) {
+            testOutputDiv.insertAdjacentElement('afterbegin', testifyFailuresElement(testStatus.TestifyFailures))
+          }
+          if (testStatus.Panic != null) {
+            testOutputDiv.insertAdjacentElement('afterbegin', panicElement(testStatus.Panic))
+          }
+          if (testStatus.Races != null && testStatus.Races.length > 0) {
+            testOutputDiv.insertAdjacentElement('afterbegin', testRacesElement(testStatus.Races))
+          }
+          if (testStatus.FuzzFailure != null) {
+            testOutputDiv.insertAdjacentElement('beforeend', fuzzFailureElement(testStatus.FuzzFailure))
+          }
           testOutputDiv.insertAdjacentElement('beforeend', testDetailDiv)
           target.insertAdjacentElement('beforeend', testOutputDiv)
 
-          if (testStatus.Passed) {
-            consolePre.classList.remove('skipped')
-            consolePre.classList.remove('failed')
-          } else if (testStatus.Skipped) {
-            consolePre.classList.add('skipped')
-            consolePre.classList.remove('failed')
-          } else {
-            consolePre.classList.remove('skipped')
-            consolePre.classList.add('failed')
-          }
-          consolePre.textContent = testStatus.Output.join('')
+          setConsoleStatus(consolePre, testStatus)
+          setConsoleOutput(consolePre, testStatus.Output)
         } else {
           testOutputDiv.remove()
         }
       }
+    },
+
+    /**
+     * Invoked when a user clicks on one of the attempt tabs of a test that ran more than once. Shows the output of
+     * the selected attempt, or the output of all attempts.
+     * @param {Element} target The attempt tab element.
+     * @param {TestResults} data
+     */
+    attemptTabHandler: function (target, data) {
+      const testOutputDiv = /**@type {Element}*/ target.closest('div.testOutput')
+      const testStatus = /**@type {TestStatus}*/ getTestStatus(testOutputDiv.parentElement, data)
+      const consolePre = /**@type {HTMLElement}*/ testOutputDiv.querySelector('pre.console')
+      const attemptIndex = /**@type {string}*/ target.attributes['data-attempt'].value
+      const status = /**@type {TestStatus|TestAttempt}*/ (attemptIndex === '') ? testStatus : testStatus.Attempts[attemptIndex]
+      testOutputDiv.querySelectorAll('.attemptTab')
+                   .forEach((elem) => elem.classList.remove('selected'))
+      target.classList.add('selected')
+      setConsoleStatus(consolePre, status)
+      setConsoleOutput(consolePre, status.Output)
+    },
+
+    /**
+     * Invoked when a user clicks on the toggle of the output console of a test whose failure messages contain diffs.
+     * Switches between the highlighted diffs and the raw output of the selected attempt.
+     * @param {Element} target The toggle element.
+     * @param {TestResults} data
+     */
+    rawOutputToggleHandler: function (target, data) {
+      const testOutputDiv = /**@type {Element}*/ target.closest('div.testOutput')
+      const testStatus = /**@type {TestStatus}*/ getTestStatus(testOutputDiv.parentElement, data)
+      const consolePre = /**@type {HTMLElement}*/ testOutputDiv.querySelector('pre.console')
+      const selectedTab = /**@type {Element|null}*/ testOutputDiv.querySelector('.attemptTab.selected')
+      const attemptIndex = /**@type {string}*/ (selectedTab == null) ? '' : selectedTab.attributes['data-attempt'].value
+      const status = /**@type {TestStatus|TestAttempt}*/ (attemptIndex === '') ? testStatus : testStatus.Attempts[attemptIndex]
+      const raw = /**@type {boolean}*/ consolePre.classList.toggle('raw')
+      target.textContent = raw ? 'Show highlighted output' : 'Show raw output'
+      setConsoleOutput(consolePre, status.Output)
+    },
+
+    /**
+     * Invoked when a user clicks on the toggle of a test that has subtests. The rows of the subtests are created
+     * the first time the test is expanded and are shown or hidden on subsequent clicks.
+     * @param {Element} target The toggle element inside of the test group row.
+     * @param {TestResults} data
+     */
+    subtestToggleHandler: function (target, data) {
+      const testGroupRow = /**@type {Element}*/ target.parentElement
+      const testStatus = /**@type {TestStatus}*/ getTestStatus(testGroupRow, data)
+      let subtestList = /**@type {Element}*/ testGroupRow.nextElementSibling
+      if (subtestList == null || subtestList.classList.contains('subtestList') === false) {
+        const attribs = testGroupRow['attributes']
+        const testId = /**@type {string}*/ attribs['data-groupid'].value
+        const testIndex = /**@type {number}*/ attribs['data-index'].value
+        const parentSubIndex = /**@type {string|null}*/ attribs.hasOwnProperty('data-subindex') ? attribs['data-subindex'].value : null
+        let subtestRows = /**@type {string}*/ ''
+        for (let i = 0; i < testStatus.Subtests.length; i++) {
+          const subtest = /**@type {TestStatus}*/ testStatus.Subtests[i]
+          const subIndex = /**@type {string}*/ (parentSubIndex == null) ? `${i}` : `${parentSubIndex}.${i}`
+          const title = /**@type {string}*/ subtest.TestName.substring(testStatus.TestName.length + 1)
+          subtestRows += testGroupRowHTML(subtest, testId, testIndex, subIndex, title)
+        }
+        subtestList = document.createElement('div')
+        subtestList.classList.add('subtestList')
+        subtestList.innerHTML = subtestRows
+        testGroupRow.insertAdjacentElement('afterend', subtestList)
+        target.classList.add('expanded')
+      } else if (subtestList.classList.toggle('collapsed')) {
+        target.classList.remove('expanded')
+      } else {
+        target.classList.add('expanded')
+      }
+    },
+
+    /**
+     * Invoked when a user clicks on a column header of the benchmark table. Sorts the rows by the values of the
+     * column, ascending on the first click and descending on the next. Benchmarks that did not report a value are
+     * always placed last.
+     * @param {Element} target The column header element.
+     */
+    benchmarkTableHandler: function (target) {
+      const headerRow = /**@type {Element}*/ target.parentElement
+      const columnIndex = /**@type {number}*/ Array.prototype.indexOf.call(headerRow.children, target)
+      const ascending = /**@type {boolean}*/ !target.classList.contains('ascending')
+      Array.prototype.forEach.call(headerRow.children, (elem) => elem.classList.remove('ascending', 'descending'))
+      target.classList.add(ascending ? 'ascending' : 'descending')
+
+      const tbody = /**@type {Element}*/ target.closest('table').querySelector('tbody')
+      const rows = /**@type {Array.<Element>}*/ Array.from(tbody.querySelectorAll('tr'))
+      const cellValue = (row) => row.children[columnIndex].textContent.trim()
+      rows.sort((rowA, rowB) => {
+        const a = /**@type {string}*/ cellValue(rowA)
+        const b = /**@type {string}*/ cellValue(rowB)
+        if (a === '' || b === '') {
+          return (a === '') - (b === '')
+        }
+        const numA = /**@type {number}*/ Number(a)
+        const numB = /**@type {number}*/ Number(b)
+        const order = /**@type {number}*/ (isNaN(numA) || isNaN(numB)) ? a.localeCompare(b) : numA - numB
+        return ascending ? order : -order
+      })
+      rows.forEach((row) => tbody.insertAdjacentElement('beforeend', row))
+    },
+
+    /**
+     * Opens the annotated source file referenced by a coverage link of the package list. The browser scrolls to the
+     * file by following the link.
+     * @param {Element} target
+     */
+    coverageLinkHandler: function (target) {
+      const coverageFileElem = /**@type {HTMLDetailsElement}*/ document.getElementById(target.getAttribute('href').substring(1))
+      if (coverageFileElem != null) {
+        coverageFileElem.open = true
+      }
     }
   }
 
@@ -186,9 +876,42 @@ window.GoTestReport = function (elements) {
                                                  goTestReport.testGroupListHandler))
 
   elements.testGroupListElem
-          .addEventListener('click', event =>
-            goTestReport.testGroupListHandler(/**@type {Element}*/ event.target,
-                                              elements.data))
+          .addEventListener('click', event => {
+            const target = /**@type {Element}*/ event.target
+            if (target.classList.contains('subtestToggle')) {
+              goTestReport.subtestToggleHandler(target, elements.data)
+            } else if (target.classList.contains('attemptTab')) {
+              goTestReport.attemptTabHandler(target, elements.data)
+            } else if (target.classList.contains('rawOutputToggle')) {
+              goTestReport.rawOutputToggleHandler(target, elements.data)
+            } else {
+              goTestReport.testGroupListHandler(target, elements.data)
+            }
+          })
+
+  if (elements.benchmarkTableElem != null) {
+    elements.benchmarkTableElem
+            .addEventListener('click', event => {
+              const target = /**@type {Element}*/ event.target
+              if (target.tagName === 'TH') {
+                goTestReport.benchmarkTableHandler(target)
+              }
+            })
+  }
+
+  if (elements.raceListElem != null && elements.races != null) {
+    elements.races.forEach((race) => elements.raceListElem.insertAdjacentElement('beforeend', raceElement(race)))
+  }
+
+  if (elements.packageListElem != null) {
+    elements.packageListElem
+            .addEventListener('click', event => {
+              const target = /**@type {Element}*/ event.target
+              if (target.classList.contains('coverageLink')) {
+                goTestReport.coverageLinkHandler(target)
+              }
+            })
+  }
 
   return goTestReport
 }
@@ -162,4 +162,558 @@ test('test testGroupListHandler using [test group: 1]', () => {
   expect(packageElem.innerHTML).toBe(`<strong>Package:</strong> test/package 4`)
   const filenameElem = testDetailElem.querySelector('.filename')
   expect(filenameElem.innerHTML).toBe(`<strong>Filename:</strong> test_test_3.go &nbsp;&nbsp;<strong>Line:</strong> 101 <strong>Col:</strong> 9`)
-})
\ No newline at end of file
+})
+test('test subtestToggleHandler expands and collapses the subtests of a test', () => {
+  const subtestData = [{
+    "TestResults": [{
+      TestName: "TestParse",
+      Package: "test/package 1",
+      Passed: false,
+      ElapsedTime: 0.5,
+      Output: [],
+      TestFileName: "parse_test.go",
+      TestFunctionDetail: {Line: 12, Col: 1},
+      NumOfSubtestsPassed: 1,
+      NumOfSubtestsFailed: 1,
+      NumOfSubtestsSkipped: 0,
+      Subtests: [{
+        TestName: "TestParse/bad_input",
+        Package: "test/package 1",
+        Passed: false,
+        ElapsedTime: 0.25,
+        Output: ["bad input output\n"],
+        TestFileName: "parse_test.go",
+        TestFunctionDetail: {Line: 12, Col: 1},
+      }, {
+        TestName: "TestParse/empty_input",
+        Package: "test/package 1",
+        Passed: true,
+        ElapsedTime: 0.25,
+        Output: ["empty input output\n"],
+        TestFileName: "parse_test.go",
+        TestFunctionDetail: {Line: 12, Col: 1},
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const container = document.createElement('div')
+  const rowElem = createDataGroupElement(0, 0)
+  const toggleElem = document.createElement('span')
+  toggleElem.classList.add('subtestToggle')
+  rowElem.insertAdjacentElement('beforeend', toggleElem)
+  container.insertAdjacentElement('beforeend', rowElem)
+
+  goTestReport.subtestToggleHandler(toggleElem, subtestData)
+  const subtestList = container.querySelector('.subtestList')
+  expect(subtestList).not.toBeNull()
+  expect(toggleElem.classList.contains('expanded')).toBe(true)
+  const subtestRows = subtestList.querySelectorAll('.testGroupRow')
+  expect(subtestRows.length).toBe(2)
+  expect(subtestRows[0].classList.contains('failed')).toBe(true)
+  expect(subtestRows[0].querySelector('.testTitle').textContent).toBe('bad_input')
+  expect(subtestRows[1].getAttribute('data-subindex')).toBe('1')
+
+  goTestReport.testGroupListHandler(subtestRows[1], subtestData)
+  expect(subtestRows[1].querySelector('.console').textContent).toBe('empty input output\n')
+
+  goTestReport.subtestToggleHandler(toggleElem, subtestData)
+  expect(subtestList.classList.contains('collapsed')).toBe(true)
+  expect(toggleElem.classList.contains('expanded')).toBe(false)
+})
+
+test('test subtestToggleHandler escapes the names of the subtests', () => {
+  const subtestData = [{
+    "TestResults": [{
+      TestName: "TestParse",
+      Package: "test/package 1",
+      Passed: true,
+      ElapsedTime: 0.5,
+      Output: [],
+      TestFileName: "parse_test.go",
+      TestFunctionDetail: {Line: 12, Col: 1},
+      NumOfSubtestsPassed: 1,
+      Subtests: [{
+        TestName: "TestParse/<b>x",
+        Package: "test/package 1",
+        Passed: true,
+        ElapsedTime: 0.5,
+        Output: [],
+        TestFileName: "parse_test.go",
+        TestFunctionDetail: {Line: 12, Col: 1},
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const container = document.createElement('div')
+  const rowElem = createDataGroupElement(0, 0)
+  const toggleElem = document.createElement('span')
+  toggleElem.classList.add('subtestToggle')
+  rowElem.insertAdjacentElement('beforeend', toggleElem)
+  container.insertAdjacentElement('beforeend', rowElem)
+
+  goTestReport.subtestToggleHandler(toggleElem, subtestData)
+  const titleElem = container.querySelector('.subtestList .testTitle')
+  expect(titleElem.textContent).toBe('<b>x')
+  expect(titleElem.querySelector('b')).toBeNull()
+})
+
+test('test testGroupListHandler shows the input file of a test', () => {
+  const sourceData = [{
+    "TestResults": [{
+      TestName: "TestFunc",
+      Package: "test/package 1",
+      Source: "shards/<i>shard-1.json",
+      Passed: true,
+      ElapsedTime: 0,
+      Output: [],
+      TestFileName: "func_test.go",
+      TestFunctionDetail: {Line: 3, Col: 1},
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, sourceData)
+  const sourceElem = rowElem.querySelector('.testDetail .source')
+  expect(sourceElem.textContent).toBe('Source: shards/<i>shard-1.json')
+  expect(sourceElem.querySelector('i')).toBeNull()
+})
+
+test('test attemptTabHandler shows the output of the selected attempt', () => {
+  const attemptData = [{
+    "TestResults": [{
+      TestName: "TestRetry",
+      Package: "test/package 1",
+      Passed: false,
+      Flaky: true,
+      ElapsedTime: 0.75,
+      Output: ["attempt 1 output\n", "attempt 2 output\n"],
+      TestFileName: "retry_test.go",
+      TestFunctionDetail: {Line: 8, Col: 1},
+      Attempts: [{
+        Passed: false,
+        ElapsedTime: 0.5,
+        Output: ["attempt 1 output\n"],
+      }, {
+        Passed: true,
+        ElapsedTime: 0.25,
+        Output: ["attempt 2 output\n"],
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, attemptData)
+  const consolePre = rowElem.querySelector('.console')
+  const attemptTabs = rowElem.querySelectorAll('.attemptTab')
+  expect(attemptTabs.length).toBe(3)
+  expect(attemptTabs[0].classList.contains('selected')).toBe(true)
+  expect(attemptTabs[1].classList.contains('failed')).toBe(true)
+  expect(consolePre.textContent).toBe('attempt 1 output\nattempt 2 output\n')
+
+  goTestReport.attemptTabHandler(attemptTabs[2], attemptData)
+  expect(attemptTabs[2].classList.contains('selected')).toBe(true)
+  expect(attemptTabs[0].classList.contains('selected')).toBe(false)
+  expect(consolePre.textContent).toBe('attempt 2 output\n')
+  expect(consolePre.classList.contains('failed')).toBe(false)
+
+  goTestReport.attemptTabHandler(attemptTabs[1], attemptData)
+  expect(consolePre.textContent).toBe('attempt 1 output\n')
+  expect(consolePre.classList.contains('failed')).toBe(true)
+})
+
+test('test testGroupListHandler marks the attempts that never finished', () => {
+  const attemptData = [{
+    "TestResults": [{
+      TestName: "TestRetry",
+      Package: "test/package 1",
+      Passed: false,
+      Incomplete: true,
+      ElapsedTime: 0.25,
+      Output: ["attempt 1 output\n", "attempt 2 output\n"],
+      TestFileName: "retry_test.go",
+      TestFunctionDetail: {Line: 8, Col: 1},
+      Attempts: [{
+        Passed: true,
+        ElapsedTime: 0.25,
+        Output: ["attempt 1 output\n"],
+      }, {
+        Passed: false,
+        Incomplete: true,
+        ElapsedTime: 0,
+        Output: ["attempt 2 output\n"],
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, attemptData)
+  const attemptTabs = rowElem.querySelectorAll('.attemptTab')
+  expect(attemptTabs.length).toBe(3)
+  expect(attemptTabs[1].classList.contains('passed')).toBe(true)
+  expect(attemptTabs[2].classList.contains('incomplete')).toBe(true)
+  expect(attemptTabs[2].classList.contains('failed')).toBe(false)
+  expect(attemptTabs[2].textContent).toBe('#2 ? 0s')
+})
+
+test('test benchmarkTableHandler sorts the benchmarks by the selected column', () => {
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const table = document.createElement('table')
+  table.innerHTML = `<thead><tr><th class="text">Benchmark</th><th>ns/op</th></tr></thead>
+    <tbody>
+      <tr><td class="text">BenchmarkB</td><td>12.5</td></tr>
+      <tr><td class="text">BenchmarkC</td><td></td></tr>
+      <tr><td class="text">BenchmarkA</td><td>1250</td></tr>
+    </tbody>`
+  const benchmarkNames = () => Array.from(table.querySelectorAll('tbody tr'))
+                                    .map((row) => row.children[0].textContent)
+  const nsPerOpHeader = table.querySelectorAll('th')[1]
+
+  goTestReport.benchmarkTableHandler(nsPerOpHeader)
+  expect(nsPerOpHeader.classList.contains('ascending')).toBe(true)
+  expect(benchmarkNames()).toEqual(['BenchmarkB', 'BenchmarkA', 'BenchmarkC'])
+
+  goTestReport.benchmarkTableHandler(nsPerOpHeader)
+  expect(nsPerOpHeader.classList.contains('descending')).toBe(true)
+  expect(benchmarkNames()).toEqual(['BenchmarkA', 'BenchmarkB', 'BenchmarkC'])
+
+  const nameHeader = table.querySelectorAll('th')[0]
+  goTestReport.benchmarkTableHandler(nameHeader)
+  expect(nsPerOpHeader.classList.contains('descending')).toBe(false)
+  expect(benchmarkNames()).toEqual(['BenchmarkA', 'BenchmarkB', 'BenchmarkC'])
+})
+
+test('test coverageLinkHandler opens the linked source file', () => {
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const coverageFileElem = document.createElement('details')
+  coverageFileElem.id = 'coverage-3'
+  document.body.appendChild(coverageFileElem)
+  const link = document.createElement('a')
+  link.setAttribute('href', '#coverage-3')
+  link.classList.add('coverageLink')
+
+  goTestReport.coverageLinkHandler(link)
+  expect(coverageFileElem.open).toBe(true)
+  coverageFileElem.remove()
+})
+
+test('test testGroupListHandler shows the failing input of a fuzz test', () => {
+  const fuzzData = [{
+    "TestResults": [{
+      TestName: "FuzzParse",
+      Package: "test/package 1",
+      Passed: false,
+      Fuzz: true,
+      ElapsedTime: 0.12,
+      Output: ["    Failing input written to testdata/fuzz/FuzzParse/310637d0c4244160\n"],
+      TestFileName: "parse_test.go",
+      TestFunctionDetail: {Line: 5, Col: 1},
+      FuzzFailure: {
+        InputFile: "testdata/fuzz/FuzzParse/310637d0c4244160",
+        Input: "go test fuzz v1\nstring(\"<z000>\")\n",
+        ReproCommand: "go test -run='^FuzzParse$/^310637d0c4244160$' test/package",
+      }
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, fuzzData)
+  const fuzzFailureDiv = rowElem.querySelector('.fuzzFailure')
+  expect(fuzzFailureDiv).not.toBeNull()
+  expect(fuzzFailureDiv.querySelector('.inputFile').textContent).toBe('Failing input: testdata/fuzz/FuzzParse/310637d0c4244160')
+  expect(fuzzFailureDiv.querySelector('pre.input').textContent).toBe('go test fuzz v1\nstring("<z000>")\n')
+  expect(fuzzFailureDiv.querySelector('.reproCommand code').textContent).toBe("go test -run='^FuzzParse$/^310637d0c4244160$' test/package")
+})
+
+test('test testGroupListHandler shows the panic of a test', () => {
+  const panicData = [{
+    "TestResults": [{
+      TestName: "TestPanic",
+      Package: "example.com/module",
+      Passed: false,
+      ElapsedTime: 0,
+      Output: ["panic: boom <nil>\n"],
+      TestFileName: "module_test.go",
+      TestFunctionDetail: {Line: 11, Col: 1},
+      Panic: {
+        Value: "boom <nil>",
+        Goroutines: [{
+          ID: 8,
+          State: "running",
+          Frames: [
+            {Function: "testing.tRunner.func1.2", File: "testing.go", Line: 2123, Runtime: true},
+            {Function: "panic", File: "panic.go", Line: 859, Runtime: true},
+            {Function: "example.com/module.TestPanic", File: "module_test.go", Line: 13, InModule: true},
+            {Function: "example.com/other.Call", File: "other.go", Line: 42},
+            {Function: "testing.(*T).Run", File: "testing.go", Line: 2258, CreatedBy: true, Runtime: true},
+          ]
+        }, {
+          ID: 1,
+          State: "chan receive",
+          Frames: []
+        }]
+      }
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, panicData)
+  const panicDiv = rowElem.querySelector('.panic')
+  expect(panicDiv).not.toBeNull()
+  expect(panicDiv.querySelector('.panicValue').textContent).toBe('panic: boom <nil>')
+  const goroutines = panicDiv.querySelectorAll('details.goroutine')
+  expect(goroutines.length).toBe(2)
+  expect(goroutines[0].hasAttribute('open')).toBe(true)
+  expect(goroutines[1].hasAttribute('open')).toBe(false)
+  const runtimeFrames = goroutines[0].querySelectorAll('details.runtimeFrames')
+  expect(runtimeFrames.length).toBe(2)
+  expect(runtimeFrames[0].querySelectorAll('.stackFrame').length).toBe(2)
+  expect(goroutines[0].querySelectorAll('.stackFrame.inModule').length).toBe(1)
+  expect(goroutines[0].querySelector('.stackFrame.inModule .function').textContent).toBe('example.com/module.TestPanic')
+})
+
+test('test testGroupListHandler shows the failed testify assertions of a test', () => {
+  const testifyData = [{
+    "TestResults": [{
+      TestName: "TestParse",
+      Package: "example.com/module",
+      Passed: false,
+      ElapsedTime: 0,
+      Output: ["    parser_test.go:17: \n"],
+      TestFileName: "parser_test.go",
+      TestFunctionDetail: {Line: 15, Col: 1},
+      TestifyFailures: [{
+        Location: "parser_test.go:17",
+        ErrorTrace: [{File: "/src/module/parser_test.go", Line: 17}, {File: "helper_test.go", Line: 8}],
+        Error: "Not equal:",
+        ErrorDetail: "",
+        Expected: "\"<hello>\"",
+        Actual: "\"<there>\"",
+        Diff: [
+          {Kind: "header", Text: "--- Expected"},
+          {Kind: "header", Text: "+++ Actual"},
+          {Kind: "hunk", Text: "@@ -1 +1 @@"},
+          {Kind: "removed", Text: "-\"<hello>\""},
+          {Kind: "added", Text: "+\"<there>\""},
+        ],
+        Messages: "greeting",
+      }, {
+        Location: "parser_test.go:21",
+        ErrorTrace: null,
+        Error: "elements differ",
+        ErrorDetail: "extra elements in list A:",
+        Expected: "",
+        Actual: "",
+        Diff: null,
+        Messages: "",
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, testifyData)
+  const failures = rowElem.querySelectorAll('.testifyFailures .testifyFailure')
+  expect(failures.length).toBe(2)
+  expect(failures[0].querySelector('.testifyError').textContent).toBe('parser_test.go:17: Not equal:')
+  expect(failures[0].querySelector('.testifyMessages').textContent).toBe('Messages: greeting')
+  expect(failures[0].querySelector('.expected pre').textContent).toBe('"<hello>"')
+  expect(failures[0].querySelector('.actual pre').textContent).toBe('"<there>"')
+  expect(failures[0].querySelectorAll('pre.diff .diffLine').length).toBe(5)
+  expect(failures[0].querySelector('pre.diff .diffLine.removed').textContent).toBe('-"<hello>"')
+  expect(failures[0].querySelector('pre.diff .diffLine.added').textContent).toBe('+"<there>"')
+  const traceLocations = failures[0].querySelectorAll('.errorTrace .traceLocation')
+  expect(traceLocations.length).toBe(2)
+  expect(traceLocations[0].getAttribute('href')).toBe('file:///src/module/parser_test.go')
+  expect(traceLocations[1].tagName).toBe('SPAN')
+  expect(failures[1].querySelector('pre.testifyErrorDetail').textContent).toBe('extra elements in list A:')
+  expect(failures[1].querySelector('.testifyValues')).toBeNull()
+  expect(failures[1].querySelector('pre.diff')).toBeNull()
+  expect(failures[1].querySelector('.errorTrace')).toBeNull()
+})
+
+test('test subtestToggleHandler marks the subtests that timed out', () => {
+  const timeoutData = [{
+    "TestResults": [{
+      TestName: "TestSlow",
+      Package: "test/package 1",
+      Passed: false,
+      TimedOut: true,
+      ElapsedTime: 60,
+      Output: [],
+      TestFileName: "slow_test.go",
+      TestFunctionDetail: {Line: 10, Col: 1},
+      NumOfSubtestsPassed: 0,
+      NumOfSubtestsFailed: 1,
+      NumOfSubtestsSkipped: 0,
+      Subtests: [{
+        TestName: "TestSlow/sub",
+        Package: "test/package 1",
+        Passed: false,
+        TimedOut: true,
+        ElapsedTime: 59.5,
+        Output: [],
+        TestFileName: "slow_test.go",
+        TestFunctionDetail: {Line: 10, Col: 1},
+      }]
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const container = document.createElement('div')
+  const rowElem = createDataGroupElement(0, 0)
+  const toggleElem = document.createElement('span')
+  toggleElem.classList.add('subtestToggle')
+  rowElem.insertAdjacentElement('beforeend', toggleElem)
+  container.insertAdjacentElement('beforeend', rowElem)
+
+  goTestReport.subtestToggleHandler(toggleElem, timeoutData)
+  const subtestRow = container.querySelector('.subtestList .testGroupRow')
+  expect(subtestRow.classList.contains('failed')).toBe(true)
+  expect(subtestRow.classList.contains('timedout')).toBe(true)
+  expect(subtestRow.querySelector('.testStatus').textContent).toBe('⌛')
+})
+
+test('test GoTestReport lists the data races and links them from the output of the tests', () => {
+  const race = {
+    ID: "race-1",
+    Location: "Location is global 'counter' of size 8 at 0x000000832568 (module.test+0x000000832568)",
+    Accesses: [{
+      Description: "Read at 0x000000832568 by goroutine 8",
+      Frames: [{Function: "example.com/module.Inc", File: "module.go", Line: 6, InModule: true}]
+    }, {
+      Description: "Previous write at 0x000000832568 by goroutine 7",
+      Frames: [{Function: "example.com/module.Inc", File: "module.go", Line: 6, InModule: true}]
+    }],
+    Goroutines: [{ID: 8, State: "running", Frames: []}],
+    Tests: [{TestName: "TestRace", Package: "example.com/module"}]
+  }
+  const raceData = [{
+    "TestResults": [{
+      TestName: "TestRace",
+      Package: "example.com/module",
+      Passed: false,
+      ElapsedTime: 0,
+      Output: ["WARNING: DATA RACE\n"],
+      TestFileName: "module_test.go",
+      TestFunctionDetail: {Line: 5, Col: 1},
+      Races: ["race-1"]
+    }]
+  }]
+  const testElements = createTestElements()
+  testElements.races = [race]
+  testElements.raceListElem = document.createElement('div')
+  const goTestReport = new window.GoTestReport(testElements);
+  const raceDiv = testElements.raceListElem.querySelector('.dataRace#race-1')
+  expect(raceDiv).not.toBeNull()
+  expect(raceDiv.querySelector('.raceTitle strong').textContent).toBe('Data race #1')
+  expect(raceDiv.querySelector('.raceTests').textContent).toBe('Reported by: TestRace (example.com/module)')
+  expect(raceDiv.querySelectorAll('details.raceAccess[open]').length).toBe(2)
+  expect(raceDiv.querySelectorAll('details.goroutine').length).toBe(1)
+
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, raceData)
+  expect(rowElem.querySelector('.races a').getAttribute('href')).toBe('#race-1')
+})
+
+test('test testGroupListHandler shows the failure summary of a failed test in its row', () => {
+  const failureData = [{
+    "TestResults": [{
+      TestName: "TestParse",
+      Package: "example.com/module",
+      Passed: false,
+      ElapsedTime: 0,
+      Output: ["    parser_test.go:42: expected <a>, got <b>\n"],
+      TestFileName: "parser_test.go",
+      TestFunctionDetail: {Line: 40, Col: 1},
+      FailureSummary: "parser_test.go:42: expected <a>, got <b>"
+    }, {
+      TestName: "TestFormat",
+      Package: "example.com/module",
+      Passed: true,
+      ElapsedTime: 0,
+      Output: [],
+      TestFileName: "parser_test.go",
+      TestFunctionDetail: {Line: 50, Col: 1},
+      FailureSummary: ""
+    }]
+  }]
+  const testElements = createTestElements()
+  const goTestReport = new window.GoTestReport(testElements);
+  const testResultGroup = document.createElement('div')
+  testResultGroup.id = '0'
+  testResultGroup.classList.add('testResultGroup')
+  goTestReport.testResultsClickHandler(testResultGroup, false, failureData, {testResults: null, selectedTestGroupColor: null}, goTestReport.testGroupListHandler)
+  const rows = testElements.testGroupListElem.querySelectorAll('.testGroupRow')
+  expect(rows.length).toBe(2)
+  expect(rows[0].querySelector('.failureSummary').textContent).toBe('parser_test.go:42: expected <a>, got <b>')
+  expect(rows[1].querySelector('.failureSummary')).toBeNull()
+})
+
+test('test rawOutputToggleHandler switches between the highlighted diffs and the raw output', () => {
+  const output = [
+    "=== RUN   TestConfig\n",
+    "    config_test.go:12: got \"<foo>\", want \"<bar>\"\n",
+    "    config_test.go:18: mismatch (-want +got):\n",
+    "          main.Config{\n",
+    "        - \tName: \"foo\",\n",
+    "        + \tName: \"bar\",\n",
+    "        + \tPort: 80,\n",
+    "          }\n",
+    "    config_test.go:25:\n",
+    "        got:  [1 2 3]\n",
+    "        want: [1 4 3]\n",
+    "--- FAIL: TestConfig (0.00s)\n",
+  ]
+  const diffData = [{
+    "TestResults": [{
+      TestName: "TestConfig",
+      Package: "example.com/module",
+      Passed: false,
+      ElapsedTime: 0,
+      Output: output,
+      TestFileName: "config_test.go",
+      TestFunctionDetail: {Line: 10, Col: 1},
+    }]
+  }]
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, diffData)
+  const consolePre = rowElem.querySelector('.console')
+  // the highlighted output keeps the text of the output
+  expect(consolePre.textContent).toBe(output.join(''))
+
+  const values = consolePre.querySelectorAll('.diffValue')
+  expect(values.length).toBe(4)
+  expect(values[0].classList.contains('got')).toBe(true)
+  expect(values[0].textContent).toBe('"<foo>"')
+  expect(values[0].querySelector('mark').textContent).toBe('foo')
+  expect(values[1].classList.contains('want')).toBe(true)
+  expect(values[1].querySelector('mark').textContent).toBe('bar')
+  expect(values[2].querySelector('mark').textContent).toBe('2')
+  expect(values[3].querySelector('mark').textContent).toBe('4')
+
+  const removed = consolePre.querySelectorAll('.diffLine.removed')
+  const added = consolePre.querySelectorAll('.diffLine.added')
+  expect(removed.length).toBe(1)
+  expect(added.length).toBe(2)
+  expect(removed[0].querySelector('mark').textContent).toBe('foo')
+  expect(added[0].querySelector('mark').textContent).toBe('bar')
+  expect(added[1].querySelector('mark')).toBeNull()
+
+  const rawOutputToggle = rowElem.querySelector('.rawOutputToggle')
+  expect(rawOutputToggle).not.toBeNull()
+  goTestReport.rawOutputToggleHandler(rawOutputToggle, diffData)
+  expect(consolePre.classList.contains('raw')).toBe(true)
+  expect(consolePre.querySelector('.diffValue')).toBeNull()
+  expect(consolePre.textContent).toBe(output.join(''))
+
+  goTestReport.rawOutputToggleHandler(rawOutputToggle, diffData)
+  expect(consolePre.classList.contains('raw')).toBe(false)
+  expect(consolePre.querySelectorAll('.diffValue').length).toBe(4)
+})
+
+test('test testGroupListHandler shows the output without diffs as is', () => {
+  const goTestReport = new window.GoTestReport(createTestElements());
+  const rowElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(rowElem, mockData)
+  expect(rowElem.querySelector('.rawOutputToggle')).toBeNull()
+  expect(rowElem.querySelector('.console').innerHTML).toBe('test output A 1\ntest output A 2\ntest output A 3\n')
+})
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// testifyFailure is a failed assertion of the testify package, parsed from the block of labeled fields it prints
+	// into the output of a test, e.g.
+	//
+	//	    parser_test.go:42:
+	//	        	Error Trace:	parser_test.go:42
+	//	        	Error:      	Not equal:
+	//	        	            	expected: 1
+	//	        	            	actual  : 2
+	//	        	Test:       	TestParse
+	testifyFailure struct {
+		// the location of the failed assertion, e.g. "parser_test.go:42"
+		Location   string
+		ErrorTrace []*traceLocation
+		// the first line of the error, e.g. "Not equal:" or "Should be true"
+		Error string
+		// the remaining lines of an error without expected and actual values, e.g. the elements of an
+		// "elements differ" error
+		ErrorDetail string
+		Expected    string
+		Actual      string
+		// the unified diff of the expected and the actual value, empty if testify did not print a diff
+		Diff     []*diffLine
+		Messages string
+	}
+
+	traceLocation struct {
+		// the file as printed by testify, either a path or the name of the file
+		File string
+		Line int
+	}
+
+	diffLine struct {
+		// header, hunk, removed, added or context
+		Kind string
+		Text string
+	}
+)
+
+var (
+	// e.g. "actual  : 2"
+	testifyActualRegexp = regexp.MustCompile(`^actual\s*: ?`)
+	// e.g. "/src/module/parser_test.go:42"
+	traceLocationRegexp = regexp.MustCompile(`^(.+):(\d+)$`)
+)
+
+// readTestifyFailures parses the failed testify assertions in the output of the failed tests.
+func readTestifyFailures(allTests map[string]*testStatus) {
+	for _, status := range allTests {
+		status.TestifyFailures = nil
+		if status.Passed || status.Skipped {
+			continue
+		}
+		status.TestifyFailures = parseTestifyFailures(status.Output)
+	}
+}
+
+// parseTestifyFailures returns the failed testify assertions found in the output. A block is recognized by the
+// location logged by the test followed by an "Error Trace:" field, the following lines of the block are fields
+// indented deeper than the location.
+func parseTestifyFailures(output []string) []*testifyFailure {
+	var failures []*testifyFailure
+	lines := strings.Split(strings.Join(output, ""), "\n")
+	for i := 0; i < len(lines); i++ {
+		match := testLogLineRegexp.FindStringSubmatch(strings.TrimRight(lines[i], "\r"))
+		if match == nil || strings.TrimSpace(match[3]) != "" || i+1 >= len(lines) {
+			continue
+		}
+		if label, _, ok := testifyField(lines[i+1], len(match[1])); !ok || label != "Error Trace" {
+			continue
+		}
+		fields := map[string][]string{}
+		label := ""
+		for i++; i < len(lines); i++ {
+			fieldLabel, content, ok := testifyField(lines[i], len(match[1]))
+			if !ok {
+				break
+			}
+			if fieldLabel != "" {
+				label = fieldLabel
+			}
+			fields[label] = append(fields[label], content)
+		}
+		// the line following the block is the start of the next block, or other output of the test
+		i--
+		// blocks without an error are not printed by testify, they are only shown as part of the output
+		if failure := newTestifyFailure(match[2], fields); failure.Error != "" {
+			failures = append(failures, failure)
+		}
+	}
+	return failures
+}
+
+// testifyField splits a line of a testify block into the label of the field and its content, the label is empty for
+// the following lines of a field. The line must be indented deeper than the location of the block.
+func testifyField(line string, indent int) (label string, content string, ok bool) {
+	line = strings.TrimRight(line, "\r")
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) <= indent || !strings.HasPrefix(trimmed, "\t") {
+		return "", "", false
+	}
+	// e.g. "\tError:      \tNot equal: " or "\t            \texpected: 1"
+	parts := strings.SplitN(trimmed[1:], "\t", 2)
+	if len(parts) == 2 {
+		content = parts[1]
+	}
+	return strings.TrimSuffix(strings.TrimSpace(parts[0]), ":"), content, true
+}
+
+// newTestifyFailure returns the assertion described by the fields of a testify block.
+func newTestifyFailure(location string, fields map[string][]string) *testifyFailure {
+	failure := &testifyFailure{Location: location}
+	for _, trace := range fields["Error Trace"] {
+		trace = strings.TrimSpace(trace)
+		if match := traceLocationRegexp.FindStringSubmatch(trace); match != nil {
+			line, _ := strconv.Atoi(match[2])
+			failure.ErrorTrace = append(failure.ErrorTrace, &traceLocation{File: match[1], Line: line})
+		}
+	}
+	failure.Messages = strings.TrimSpace(strings.Join(fields["Messages"], "\n"))
+	errorLines := fields["Error"]
+	if len(errorLines) == 0 {
+		return failure
+	}
+	failure.Error = strings.TrimSpace(errorLines[0])
+
+	// the lines of the error following its first line are the expected and the actual value followed by their
+	// diff, or the detail of an error without values
+	var expected, actual, detail []string
+	section := &detail
+	inDiff := false
+	for _, line := range errorLines[1:] {
+		switch {
+		case inDiff:
+			failure.Diff = append(failure.Diff, newDiffLine(line))
+		case strings.HasPrefix(line, "expected:") && section == &detail && len(expected) == 0:
+			expected = []string{strings.TrimPrefix(strings.TrimPrefix(line, "expected:"), " ")}
+			section = &expected
+		case testifyActualRegexp.MatchString(line) && section == &expected:
+			actual = []string{testifyActualRegexp.ReplaceAllString(line, "")}
+			section = &actual
+		case strings.TrimSpace(line) == "Diff:" && section == &actual:
+			inDiff = true
+		default:
+			*section = append(*section, line)
+		}
+	}
+	failure.Expected = strings.TrimRight(strings.Join(expected, "\n"), " \n")
+	failure.Actual = strings.TrimRight(strings.Join(actual, "\n"), " \n")
+	failure.ErrorDetail = strings.Trim(strings.Join(detail, "\n"), " \n")
+	// the diff ends with the empty context line of the final newline of the values
+	for len(failure.Diff) > 0 && strings.TrimSpace(failure.Diff[len(failure.Diff)-1].Text) == "" {
+		failure.Diff = failure.Diff[:len(failure.Diff)-1]
+	}
+	return failure
+}
+
+// newDiffLine classifies a line of a unified diff.
+func newDiffLine(line string) *diffLine {
+	kind := "context"
+	switch {
+	case strings.HasPrefix(line, "--- ") || strings.HasPrefix(line, "+++ "):
+		kind = "header"
+	case strings.HasPrefix(line, "@@"):
+		kind = "hunk"
+	case strings.HasPrefix(line, "-"):
+		kind = "removed"
+	case strings.HasPrefix(line, "+"):
+		kind = "added"
+	}
+	return &diffLine{Kind: kind, Text: line}
+}
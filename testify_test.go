@@ -0,0 +1,102 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the output of a test with failed testify assertions, as printed by go test
+var testifyOutput = []string{
+	"=== RUN   TestParse\n",
+	"    parser_test.go:17: \n",
+	"        \tError Trace:\tparser_test.go:17\n",
+	"        \tError:      \tNot equal: \n",
+	"        \t            \texpected: \"hello\\nworld\\n\"\n",
+	"        \t            \tactual  : \"hello\\nthere\\n\"\n",
+	"        \t            \t\n",
+	"        \t            \tDiff:\n",
+	"        \t            \t--- Expected\n",
+	"        \t            \t+++ Actual\n",
+	"        \t            \t@@ -1,3 +1,3 @@\n",
+	"        \t            \t hello\n",
+	"        \t            \t-world\n",
+	"        \t            \t+there\n",
+	"        \t            \t \n",
+	"        \tTest:       \tTestParse\n",
+	"    parser_test.go:13: \n",
+	"        \tError Trace:\t/src/module/parser_test.go:13\n",
+	"        \t            \t\t\t\t/src/module/parser_test.go:19\n",
+	"        \tError:      \tNot equal: \n",
+	"        \t            \texpected: 1\n",
+	"        \t            \tactual  : 2\n",
+	"        \tTest:       \tTestParse\n",
+	"        \tMessages:   \tnumbers 1\n",
+	"    parser_test.go:20: logged by the test\n",
+	"    parser_test.go:21: \n",
+	"        \tError Trace:\tparser_test.go:21\n",
+	"        \tError:      \telements differ\n",
+	"        \t            \t\n",
+	"        \t            \textra elements in list A:\n",
+	"        \t            \t([]interface {}) (len=1) {\n",
+	"        \t            \t (int) 2\n",
+	"        \t            \t}\n",
+	"        \tTest:       \tTestParse\n",
+	"--- FAIL: TestParse (0.00s)\n",
+}
+
+func TestParseTestifyFailures(t *testing.T) {
+	assertions := assert.New(t)
+	failures := parseTestifyFailures(testifyOutput)
+	assertions.Len(failures, 3)
+
+	assertions.Equal(&testifyFailure{
+		Location:   "parser_test.go:17",
+		ErrorTrace: []*traceLocation{{File: "parser_test.go", Line: 17}},
+		Error:      "Not equal:",
+		Expected:   `"hello\nworld\n"`,
+		Actual:     `"hello\nthere\n"`,
+		Diff: []*diffLine{
+			{Kind: "header", Text: "--- Expected"},
+			{Kind: "header", Text: "+++ Actual"},
+			{Kind: "hunk", Text: "@@ -1,3 +1,3 @@"},
+			{Kind: "context", Text: " hello"},
+			{Kind: "removed", Text: "-world"},
+			{Kind: "added", Text: "+there"},
+		},
+	}, failures[0])
+
+	// an assertion of a helper function is traced to the helper and to the test calling it
+	assertions.Equal(&testifyFailure{
+		Location: "parser_test.go:13",
+		ErrorTrace: []*traceLocation{
+			{File: "/src/module/parser_test.go", Line: 13},
+			{File: "/src/module/parser_test.go", Line: 19},
+		},
+		Error:    "Not equal:",
+		Expected: "1",
+		Actual:   "2",
+		Messages: "numbers 1",
+	}, failures[1])
+
+	// an error without expected and actual values
+	assertions.Equal("elements differ", failures[2].Error)
+	assertions.Equal("extra elements in list A:\n([]interface {}) (len=1) {\n (int) 2\n}", failures[2].ErrorDetail)
+	assertions.Empty(failures[2].Expected)
+	assertions.Empty(failures[2].Diff)
+
+	// the output of a test not using testify, and a block that is not printed by testify
+	assertions.Nil(parseTestifyFailures([]string{"    parser_test.go:42: expected 1, got 2\n"}))
+	assertions.Nil(parseTestifyFailures([]string{"    parser_test.go:42: \n", "        \tError Trace:\tparser_test.go:42\n"}))
+}
+
+func TestReadTestifyFailures(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*testStatus{
+		"foo.TestFailed": {TestName: "TestFailed", Output: testifyOutput},
+		"foo.TestPassed": {TestName: "TestPassed", Output: testifyOutput, Passed: true},
+	}
+	readTestifyFailures(allTests)
+	assertions.Len(allTests["foo.TestFailed"].TestifyFailures, 3)
+	assertions.Nil(allTests["foo.TestPassed"].TestifyFailures)
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+type (
+	// testInterval is a period of time during which a test was running. A test calling t.Parallel is paused until
+	// the sequential tests of its package (or the subtests of its parent) finished and is continued afterwards, so a
+	// parallel test runs in more than one interval.
+	testInterval struct {
+		start time.Time
+		// zero while the test is running
+		end time.Time
+	}
+
+	// packageTimeline is the execution timeline of the tests of a package, rendered as an SVG Gantt chart with one
+	// row per test.
+	packageTimeline struct {
+		Width  int
+		Height int
+		// the position of the time axis below the rows
+		AxisY    int
+		Duration time.Duration
+		Rows     []*timelineRow
+		Ticks    []*timelineTick
+	}
+
+	timelineRow struct {
+		// the name of the test, shortened to fit the label column of the chart
+		Label    string
+		TestName string
+		// pass, fail, skip or incomplete
+		Status string
+		Y      int
+		// the time from the first start to the last end of the test, including the time the test was paused
+		Span *timelineBar
+		Bars []*timelineBar
+	}
+
+	timelineBar struct {
+		X     float64
+		Width float64
+		Title string
+	}
+
+	timelineTick struct {
+		X     float64
+		Label string
+	}
+)
+
+// the layout of the timeline chart in pixels
+const (
+	timelineLabelWidth = 300
+	timelineChartWidth = 700
+	timelineRowHeight  = 18
+	timelineAxisHeight = 20
+	// the space right of the chart, leaving room for the label of the last tick
+	timelineMargin = 40
+	// the label of a test is shortened to this number of characters
+	timelineMaxLabelLength = 44
+)
+
+// readTestInterval starts an interval of the test on "run" and "cont" events and ends it on "pause", "pass", "fail"
+// and "skip" events. Events without a timestamp are ignored.
+func readTestInterval(status *testStatus, action string, eventTime *time.Time) {
+	if eventTime == nil {
+		return
+	}
+	running := len(status.intervals) > 0 && status.intervals[len(status.intervals)-1].end.IsZero()
+	switch action {
+	case "run", "cont":
+		if !running {
+			status.intervals = append(status.intervals, &testInterval{start: *eventTime})
+		}
+	case "pause", "pass", "fail", "skip":
+		if running {
+			status.intervals[len(status.intervals)-1].end = *eventTime
+		}
+	}
+}
+
+// readTimelines adds the execution timeline of its tests to every package. Packages without timestamped "run"
+// events, e.g. the output of tools other than go test, have no timeline.
+func readTimelines(allTests map[string]*testStatus, allPackages map[string]*packageStatus) {
+	testsByPackage := map[string][]*testStatus{}
+	for _, status := range allTests {
+		if len(status.intervals) > 0 {
+			testsByPackage[status.Package] = append(testsByPackage[status.Package], status)
+		}
+	}
+	for _, pkg := range allPackages {
+		pkg.Timeline = newPackageTimeline(pkg, testsByPackage[pkg.Name])
+	}
+}
+
+// newPackageTimeline lays out the timeline of the tests of a package, ordered by the time they started. Tests that
+// never finished are running until their last event, or until the end of the package if they timed out.
+func newPackageTimeline(pkg *packageStatus, tests []*testStatus) *packageTimeline {
+	if len(tests) == 0 {
+		return nil
+	}
+	var start, end time.Time
+	intervalsByTest := map[*testStatus][]*testInterval{}
+	for _, status := range tests {
+		for _, interval := range status.intervals {
+			intervalEnd := interval.end
+			if intervalEnd.IsZero() {
+				if status.TimedOut && pkg.endTime != nil {
+					intervalEnd = *pkg.endTime
+				} else {
+					intervalEnd = *status.EndTime
+				}
+			}
+			if intervalEnd.Before(interval.start) {
+				intervalEnd = interval.start
+			}
+			intervalsByTest[status] = append(intervalsByTest[status], &testInterval{start: interval.start, end: intervalEnd})
+			if start.IsZero() || interval.start.Before(start) {
+				start = interval.start
+			}
+			if intervalEnd.After(end) {
+				end = intervalEnd
+			}
+		}
+	}
+	sort.Slice(tests, func(i, j int) bool {
+		if !tests[i].intervals[0].start.Equal(tests[j].intervals[0].start) {
+			return tests[i].intervals[0].start.Before(tests[j].intervals[0].start)
+		}
+		return tests[i].TestName < tests[j].TestName
+	})
+
+	duration := end.Sub(start)
+	timeline := &packageTimeline{
+		Width:    timelineLabelWidth + timelineChartWidth + timelineMargin,
+		Height:   len(tests)*timelineRowHeight + timelineAxisHeight,
+		AxisY:    len(tests) * timelineRowHeight,
+		Duration: duration.Round(time.Millisecond),
+	}
+	// the chart of tests that did not take measurable time is one millisecond wide
+	scale := float64(timelineChartWidth) / float64(time.Millisecond)
+	if duration > time.Millisecond {
+		scale = float64(timelineChartWidth) / float64(duration)
+	}
+	x := func(t time.Time) float64 {
+		return timelineLabelWidth + float64(t.Sub(start))*scale
+	}
+	newBar := func(status *testStatus, from time.Time, to time.Time) *timelineBar {
+		// tests taking less than a pixel are still visible
+		return &timelineBar{
+			X:     x(from),
+			Width: math.Max(x(to)-x(from), 1),
+			Title: fmt.Sprintf("%s: %s - %s (%s)", status.TestName, timelineDuration(from.Sub(start)),
+				timelineDuration(to.Sub(start)), timelineDuration(to.Sub(from))),
+		}
+	}
+	for i, status := range tests {
+		intervals := intervalsByTest[status]
+		row := &timelineRow{
+			Label:    timelineLabel(status.TestName),
+			TestName: status.TestName,
+			Status:   testStatusName(status),
+			Y:        i * timelineRowHeight,
+		}
+		for _, interval := range intervals {
+			row.Bars = append(row.Bars, newBar(status, interval.start, interval.end))
+		}
+		if len(intervals) > 1 {
+			row.Span = newBar(status, intervals[0].start, intervals[len(intervals)-1].end)
+		}
+		timeline.Rows = append(timeline.Rows, row)
+	}
+	step := timelineTickStep(duration)
+	for offset := time.Duration(0); offset <= duration; offset += step {
+		timeline.Ticks = append(timeline.Ticks, &timelineTick{
+			X:     x(start.Add(offset)),
+			Label: timelineDuration(offset),
+		})
+	}
+	return timeline
+}
+
+// timelineLabel shortens the name of a test to the width of the label column, keeping the end of the name since
+// the names of subtests start with the name of their parent.
+func timelineLabel(testName string) string {
+	runes := []rune(testName)
+	if len(runes) <= timelineMaxLabelLength {
+		return testName
+	}
+	return "…" + string(runes[len(runes)-timelineMaxLabelLength+1:])
+}
+
+// the times between the ticks of the axis of the chart
+var timelineTickSteps = []time.Duration{
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour,
+}
+
+// timelineTickStep returns the shortest time between the ticks of the axis of the chart resulting in at most 10
+// ticks.
+func timelineTickStep(duration time.Duration) time.Duration {
+	for _, step := range timelineTickSteps {
+		if duration/step < 10 {
+			return step
+		}
+	}
+	step := timelineTickSteps[len(timelineTickSteps)-1]
+	for duration/step >= 10 {
+		step *= 2
+	}
+	return step
+}
+
+// timelineDuration formats an offset or a duration of the timeline, e.g. "1.25s".
+func timelineDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadTimelines(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.000Z","Action":"start","Package":"foo"}
+{"Time":"2020-07-10T01:24:44.100Z","Action":"run","Package":"foo","Test":"TestParallel"}
+{"Time":"2020-07-10T01:24:44.100Z","Action":"output","Package":"foo","Test":"TestParallel","Output":"=== RUN   TestParallel\n"}
+{"Time":"2020-07-10T01:24:44.100Z","Action":"output","Package":"foo","Test":"TestParallel","Output":"=== PAUSE TestParallel\n"}
+{"Time":"2020-07-10T01:24:44.100Z","Action":"pause","Package":"foo","Test":"TestParallel"}
+{"Time":"2020-07-10T01:24:44.100Z","Action":"run","Package":"foo","Test":"TestSequential"}
+{"Time":"2020-07-10T01:24:44.600Z","Action":"pass","Package":"foo","Test":"TestSequential","Elapsed":0.5}
+{"Time":"2020-07-10T01:24:44.600Z","Action":"cont","Package":"foo","Test":"TestParallel"}
+{"Time":"2020-07-10T01:24:44.600Z","Action":"output","Package":"foo","Test":"TestParallel","Output":"=== CONT  TestParallel\n"}
+{"Time":"2020-07-10T01:24:45.100Z","Action":"fail","Package":"foo","Test":"TestParallel","Elapsed":0.5}
+{"Time":"2020-07-10T01:24:45.100Z","Action":"run","Package":"foo","Test":"TestHanging"}
+{"Time":"2020-07-10T01:24:45.200Z","Action":"output","Package":"foo","Test":"TestHanging","Output":"=== RUN   TestHanging\n"}
+{"Time":"2020-07-10T01:24:46.100Z","Action":"fail","Package":"foo","Elapsed":2.1}
+{"Action":"run","Package":"bar","Test":"TestFunc"}
+{"Action":"pass","Package":"bar","Test":"TestFunc","Elapsed":0.1}
+{"Action":"pass","Package":"bar","Elapsed":0.1}
+`
+	cmd := &cobra.Command{}
+	_, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+	readTimelines(allTests, allPackages)
+	assertions.Nil(allPackages["bar"].Timeline)
+
+	timeline := allPackages["foo"].Timeline
+	assertions.NotNil(timeline)
+	assertions.Equal(timelineLabelWidth+timelineChartWidth+timelineMargin, timeline.Width)
+	assertions.Equal(3*timelineRowHeight, timeline.AxisY)
+	assertions.Equal(3*timelineRowHeight+timelineAxisHeight, timeline.Height)
+	assertions.Equal(time.Second+100*time.Millisecond, timeline.Duration)
+
+	// the tests are ordered by the time they started, the parallel test was paused while the sequential test ran
+	assertions.Len(timeline.Rows, 3)
+	parallel := timeline.Rows[0]
+	assertions.Equal("TestParallel", parallel.TestName)
+	assertions.Equal("fail", parallel.Status)
+	assertions.Equal(0, parallel.Y)
+	assertions.Len(parallel.Bars, 2)
+	assertions.Equal(&timelineBar{X: 300, Width: 1, Title: "TestParallel: 0s - 0s (0s)"}, parallel.Bars[0])
+	assertions.InDelta(300+700*0.5/1.1, parallel.Bars[1].X, 0.001)
+	assertions.InDelta(700*0.5/1.1, parallel.Bars[1].Width, 0.001)
+	assertions.Equal("TestParallel: 500ms - 1s (500ms)", parallel.Bars[1].Title)
+	assertions.InDelta(700*1/1.1, parallel.Span.Width, 0.001)
+
+	sequential := timeline.Rows[1]
+	assertions.Equal("TestSequential", sequential.TestName)
+	assertions.Equal(timelineRowHeight, sequential.Y)
+	assertions.Nil(sequential.Span)
+	assertions.Len(sequential.Bars, 1)
+
+	// a test that never finished is running until its last event
+	hanging := timeline.Rows[2]
+	assertions.Equal("incomplete", hanging.Status)
+	assertions.Equal("TestHanging: 1s - 1.1s (100ms)", hanging.Bars[0].Title)
+
+	assertions.Len(timeline.Ticks, 6)
+	assertions.Equal(&timelineTick{X: 300, Label: "0s"}, timeline.Ticks[0])
+	assertions.Equal("1s", timeline.Ticks[5].Label)
+}
+
+func TestTimelineTickStep(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Equal(time.Millisecond, timelineTickStep(0))
+	assertions.Equal(time.Millisecond, timelineTickStep(9*time.Millisecond))
+	assertions.Equal(2*time.Millisecond, timelineTickStep(10*time.Millisecond))
+	assertions.Equal(200*time.Millisecond, timelineTickStep(1100*time.Millisecond))
+	assertions.Equal(5*time.Second, timelineTickStep(42*time.Second))
+	assertions.Equal(10*time.Minute, timelineTickStep(time.Hour))
+	assertions.Equal(2*time.Hour, timelineTickStep(12*time.Hour))
+}
+
+func TestTimelineLabel(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Equal("TestFunc/case", timelineLabel("TestFunc/case"))
+	label := timelineLabel("TestParseConfiguration/with_a_very_long_name_of_the_test_case")
+	assertions.Equal("…tion/with_a_very_long_name_of_the_test_case", label)
+	assertions.Len([]rune(label), timelineMaxLabelLength)
+}
+
+func TestGenerateReportWithTimeline(t *testing.T) {
+	assertions := assert.New(t)
+	start := time.Date(2020, 7, 10, 1, 24, 44, 0, time.UTC)
+	end := start.Add(time.Second)
+	allTests := map[string]*testStatus{
+		"foo.TestFunc": {
+			TestName:  "TestFunc",
+			Package:   "foo",
+			Passed:    true,
+			StartTime: &start,
+			EndTime:   &end,
+			intervals: []*testInterval{{start: start, end: end}},
+		},
+	}
+	allPackages := map[string]*packageStatus{"foo": {Name: "foo", Passed: true}}
+	readTimelines(allTests, allPackages)
+	tmplData := &templateData{numOfTestsPerGroup: 20}
+	buffer := &bytes.Buffer{}
+	writer := bufio.NewWriter(buffer)
+	err := generateReport(tmplData, allTests, allPackages, testFileDetailsByPackage{}, 0, writer)
+	assertions.Nil(err)
+	assertions.Nil(writer.Flush())
+	assertions.Contains(buffer.String(), `<strong>Timeline:</strong> 1 test(s) in 1s`)
+	assertions.Contains(buffer.String(), `<svg width="1040" height="38" viewBox="0 0 1040 38">`)
+	assertions.Contains(buffer.String(), `<g class="timelineRow pass" transform="translate(0,0)">`)
+	assertions.Contains(buffer.String(), `<rect class="timelineBar" x="300.0" y="3" width="700.0" height="12"><title>TestFunc: 0s - 1s (1s)</title></rect>`)
+}
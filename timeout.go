@@ -0,0 +1,66 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const timeoutPanicPrefix = "panic: test timed out after "
+
+// e.g. "TestSlow/sub (10m0s)" in the list of running tests printed after the timeout panic
+var runningTestRegexp = regexp.MustCompile(`^(.+) \(([^)]+)\)$`)
+
+// readTimeoutOutput collects the panic printed by go test when a test binary exceeds the -timeout, followed by the
+// list of tests that were running:
+//
+//	panic: test timed out after 10m0s
+//		running tests:
+//			TestSlow (10m0s)
+//
+// Depending on the version of go, the panic is part of the output of the package or of the last test that was
+// started.
+func readTimeoutOutput(pkg *packageStatus, output string) {
+	if strings.HasPrefix(output, timeoutPanicPrefix) {
+		pkg.Timeout = strings.TrimSpace(strings.TrimPrefix(output, timeoutPanicPrefix))
+		pkg.readingRunningTests = false
+		return
+	}
+	if pkg.Timeout == "" {
+		return
+	}
+	if strings.TrimSpace(output) == "running tests:" {
+		pkg.readingRunningTests = true
+	} else if pkg.readingRunningTests && strings.HasPrefix(output, "\t\t") {
+		pkg.RunningTests = append(pkg.RunningTests, strings.TrimSpace(output))
+	} else {
+		pkg.readingRunningTests = false
+	}
+}
+
+// markTimedOutTests marks the tests of the packages that timed out which were started but never passed, failed or
+// were skipped. The time the tests were running for is taken from the list of running tests, if go test printed it.
+func (testData *testDataReader) markTimedOutTests() {
+	runningTime := map[string]float64{}
+	for _, pkg := range testData.allPackages {
+		for _, runningTest := range pkg.RunningTests {
+			if match := runningTestRegexp.FindStringSubmatch(runningTest); match != nil {
+				if duration, err := time.ParseDuration(match[2]); err == nil {
+					runningTime[pkg.Name+"."+match[1]] = duration.Seconds()
+				}
+			}
+		}
+	}
+	for key, status := range testData.allTests {
+		pkg := testData.allPackages[status.Package]
+		if pkg == nil || pkg.Timeout == "" || len(status.Attempts) == 0 {
+			continue
+		}
+		attempt := status.Attempts[len(status.Attempts)-1]
+		if attempt.completed {
+			continue
+		}
+		attempt.ElapsedTime = runningTime[key]
+		status.TimedOut = true
+	}
+}
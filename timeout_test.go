@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadTestDataFromStdInWithTimeout(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Action":"run","Package":"foo","Test":"TestFast"}
+{"Action":"pass","Package":"foo","Test":"TestFast","Elapsed":0.25}
+{"Action":"run","Package":"foo","Test":"TestSlow"}
+{"Action":"run","Package":"foo","Test":"TestSlow/sub"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"=== RUN   TestSlow/sub\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"panic: test timed out after 1m0s\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"\trunning tests:\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"\t\tTestSlow (1m0s)\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"\t\tTestSlow/sub (59.5s)\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"\n"}
+{"Action":"output","Package":"foo","Test":"TestSlow/sub","Output":"goroutine 9 [running]:\n"}
+{"Action":"output","Package":"foo","Output":"FAIL\tfoo\t60.005s\n"}
+{"Action":"fail","Package":"foo","Elapsed":60.005}
+{"Action":"run","Package":"bar","Test":"TestHang"}
+{"Action":"output","Package":"bar","Output":"panic: test timed out after 10m0s\n"}
+{"Action":"output","Package":"bar","Output":"\n"}
+{"Action":"output","Package":"bar","Output":"goroutine 7 [running]:\n"}
+{"Action":"fail","Package":"bar","Elapsed":600.01}
+{"Action":"run","Package":"baz","Test":"TestUnfinished"}
+`
+	cmd := &cobra.Command{}
+	_, allTests, allPackages, _, err := readTestDataFromStdIn(strings.NewReader(data), &cmdFlags{}, cmd)
+	assertions.Nil(err)
+
+	foo := allPackages["foo"]
+	assertions.Equal("1m0s", foo.Timeout)
+	assertions.Equal([]string{"TestSlow (1m0s)", "TestSlow/sub (59.5s)"}, foo.RunningTests)
+	assertions.False(allTests["foo.TestFast"].TimedOut)
+	assertions.True(allTests["foo.TestFast"].Passed)
+	assertions.True(allTests["foo.TestSlow"].TimedOut)
+	assertions.Equal(60.0, allTests["foo.TestSlow"].ElapsedTime)
+	assertions.True(allTests["foo.TestSlow/sub"].TimedOut)
+	assertions.Equal(59.5, allTests["foo.TestSlow/sub"].ElapsedTime)
+	assertions.False(allTests["foo.TestSlow/sub"].Passed)
+	assertions.False(allTests["foo.TestSlow/sub"].Skipped)
+
+	// older versions of go print the panic as package output, without the list of running tests
+	bar := allPackages["bar"]
+	assertions.Equal("10m0s", bar.Timeout)
+	assertions.Empty(bar.RunningTests)
+	assertions.True(allTests["bar.TestHang"].TimedOut)
+	assertions.Equal(0.0, allTests["bar.TestHang"].ElapsedTime)
+
+	assertions.Empty(allPackages["baz"].Timeout)
+	assertions.False(allTests["baz.TestUnfinished"].TimedOut)
+}